@@ -0,0 +1,86 @@
+package money
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// Allocate splits the amount into parts proportional to weights without
+// losing a cent: the parts always add up to the amount. Parts are in the
+// currency's minor unit, or finer if the amount itself is, and leftover units
+// go to the parts with the largest remainders, first ones first on ties, e.g.
+// USD 0.05 by 1:1 is USD 0.03 and USD 0.02. errors with ErrInvalidWeights if
+// a weight is negative or all are zero.
+func (m Money) Allocate(weights ...decimal.Decimal) ([]Money, error) {
+	total := decimal.New(0, 0)
+	for _, w := range weights {
+		if w.Sign() < 0 {
+			return nil, ErrInvalidWeights
+		}
+		total = total.Add(w)
+	}
+	if total.Sign() == 0 {
+		return nil, ErrInvalidWeights
+	}
+
+	places := m.currency.Digits()
+	if exp := -m.amount.Exponent(); exp > places {
+		places = exp
+	}
+
+	units := m.amount.Shift(places).BigInt()
+	sign := units.Sign()
+	units.Abs(units)
+
+	totalRat := total.Rat()
+	parts := make([]*big.Int, len(weights))
+	remainders := make([]*big.Rat, len(weights))
+	left := new(big.Int).Set(units)
+
+	for i, w := range weights {
+		share := new(big.Rat).Mul(new(big.Rat).SetInt(units), w.Rat())
+		share.Quo(share, totalRat)
+
+		parts[i] = new(big.Int).Quo(share.Num(), share.Denom())
+		remainders[i] = new(big.Rat).Sub(share, new(big.Rat).SetInt(parts[i]))
+		left.Sub(left, parts[i])
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].Cmp(remainders[order[b]]) > 0
+	})
+	for i := 0; left.Sign() > 0; i++ {
+		parts[order[i]].Add(parts[order[i]], big.NewInt(1))
+		left.Sub(left, big.NewInt(1))
+	}
+
+	allocated := make([]Money, len(parts))
+	for i, part := range parts {
+		if sign < 0 {
+			part.Neg(part)
+		}
+		allocated[i] = Make(decimal.NewFromBigInt(part, -places), m.currency)
+	}
+	return allocated, nil
+}
+
+// Split splits the amount into n parts as equal as possible, e.g. USD 10.00
+// by 3 is USD 3.34, USD 3.33 and USD 3.33. errors with ErrInvalidWeights if
+// n < 1.
+func (m Money) Split(n int) ([]Money, error) {
+	if n < 1 {
+		return nil, ErrInvalidWeights
+	}
+
+	weights := make([]decimal.Decimal, n)
+	for i := range weights {
+		weights[i] = decimal.New(1, 0)
+	}
+	return m.Allocate(weights...)
+}
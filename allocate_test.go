@@ -0,0 +1,78 @@
+package money
+
+import (
+	"testing"
+
+	. "github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+)
+
+func checkParts(t *testing.T, funcName string, parts []Money, whole Money, expected []string) {
+	if len(parts) != len(expected) {
+		t.Errorf("%s => %v, expected %v", funcName, parts, expected)
+		return
+	}
+
+	sum := Zero(whole.Currency())
+	for i, part := range parts {
+		if part.String() != expected[i] {
+			t.Errorf("%s => %v, expected %v", funcName, parts, expected)
+			return
+		}
+		sum = sum.MustAdd(part)
+	}
+
+	if !sum.Amount().Equals(whole.Amount()) {
+		t.Errorf("%s => parts add up to %s, expected %s", funcName, sum, whole)
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	var monies = []struct {
+		money    Money
+		weights  []string
+		expected []string
+	}{
+		{Make(d("0.05"), USD), []string{"1", "1"}, []string{"USD 0.03", "USD 0.02"}},
+		{Make(d("100"), USD), []string{"1", "2", "3"}, []string{"USD 16.67", "USD 33.33", "USD 50.00"}},
+		{Make(d("-100"), USD), []string{"1", "2", "3"}, []string{"USD -16.67", "USD -33.33", "USD -50.00"}},
+		{Make(d("10"), USD), []string{"0.7", "0.2", "0.1"}, []string{"USD 7.00", "USD 2.00", "USD 1.00"}},
+		{Make(d("0.01"), USD), []string{"1", "1", "1"}, []string{"USD 0.01", "USD 0.00", "USD 0.00"}},
+		{Make(d("10"), USD), []string{"0", "1"}, []string{"USD 0.00", "USD 10.00"}},
+		{Make(d("0.005"), USD), []string{"1", "1"}, []string{"USD 0.003", "USD 0.002"}},
+		{Make(d("101"), XAF), []string{"1", "1"}, []string{"XAF 51", "XAF 50"}},
+		{Make(d("1.5"), XAU), []string{"1", "1"}, []string{"XAU 0.8", "XAU 0.7"}},
+	}
+
+	for _, m := range monies {
+		weights := make([]decimal.Decimal, len(m.weights))
+		for i, w := range m.weights {
+			weights[i] = d(w)
+		}
+
+		parts, err := m.money.Allocate(weights...)
+		if err != nil {
+			t.Errorf("%s.Allocate(%v) => unexpected error %s", m.money, m.weights, err)
+			continue
+		}
+		checkParts(t, "Money.Allocate()", parts, m.money, m.expected)
+	}
+
+	for _, weights := range [][]decimal.Decimal{{}, {d("0")}, {d("1"), d("-1")}} {
+		if _, err := Make(d("1"), USD).Allocate(weights...); err != ErrInvalidWeights {
+			t.Errorf("Money.Allocate(%v) => %v, expected ErrInvalidWeights", weights, err)
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	parts, err := Make(d("10"), USD).Split(3)
+	if err != nil {
+		t.Fatalf("Money.Split(3) => unexpected error %s", err)
+	}
+	checkParts(t, "Money.Split(3)", parts, Make(d("10"), USD), []string{"USD 3.34", "USD 3.33", "USD 3.33"})
+
+	if _, err := Make(d("10"), USD).Split(0); err != ErrInvalidWeights {
+		t.Errorf("Money.Split(0) => %v, expected ErrInvalidWeights", err)
+	}
+}
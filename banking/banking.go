@@ -0,0 +1,55 @@
+// Package banking formats and parses money.Money in bank messaging formats:
+// ISO 20022 ActiveCurrencyAndAmount, e.g. <InstdAmt Ccy="EUR">1234.56</InstdAmt>,
+// and SWIFT MT field 32A, e.g. :32A:230615EUR1234,56. Amounts are validated
+// against each format's rules and the currency's minor unit.
+package banking
+
+import (
+	"errors"
+
+	"github.com/FoxComm/money"
+	"github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrNegativeAmount is returned for negative amounts, which neither
+	// format allows; the direction of payment is given elsewhere
+	ErrNegativeAmount = errors.New("amount is negative")
+
+	// ErrTooManyDigits is returned for amounts longer than the format allows
+	ErrTooManyDigits = errors.New("amount has too many digits")
+)
+
+// validate checks that amount is non-negative, and has no more fraction
+// digits than the currency's minor unit or maxFraction for currencies
+// without one, such as XAU
+func validate(amount decimal.Decimal, c currency.Currency, maxFraction int32) error {
+	if amount.Sign() < 0 {
+		return ErrNegativeAmount
+	}
+	if c.HasMinorUnits() && c.Digits() < maxFraction {
+		maxFraction = c.Digits()
+	}
+	if !amount.Equals(amount.Truncate(maxFraction)) {
+		return money.ErrPrecisionLoss
+	}
+	return nil
+}
+
+// fixed formats amount padded to the currency's minor unit, without
+// truncating any digits, e.g. "1234.50" for USD
+func fixed(amount decimal.Decimal, c currency.Currency) string {
+	if amount.Equals(amount.Truncate(c.Digits())) {
+		return amount.StringFixed(c.Digits())
+	}
+	return amount.String()
+}
+
+func lookup(code string) (currency.Currency, error) {
+	c, ok := currency.Table[code]
+	if !ok {
+		return c, &money.ErrUnknownCurrency{Code: code}
+	}
+	return c, nil
+}
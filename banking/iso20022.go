@@ -0,0 +1,97 @@
+package banking
+
+import (
+	"encoding/xml"
+	"strings"
+
+	"github.com/FoxComm/money"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// isoFractionDigits is ActiveCurrencyAndAmount_SimpleType's fractionDigits
+	isoFractionDigits = 5
+
+	// isoTotalDigits is ActiveCurrencyAndAmount_SimpleType's totalDigits
+	isoTotalDigits = 18
+)
+
+// ActiveCurrencyAndAmount maps Money to ISO 20022's amount with a Ccy
+// attribute, e.g. <InstdAmt Ccy="EUR">1234.56</InstdAmt>. Amounts must be
+// non-negative, have at most 18 digits, and no more fraction digits than the
+// currency's minor unit, or 5 for currencies without one.
+type ActiveCurrencyAndAmount struct {
+	money.Money
+}
+
+// Validate checks the amount against the rules of ActiveCurrencyAndAmount.
+// errors with ErrNegativeAmount, ErrTooManyDigits or money.ErrPrecisionLoss.
+func (a ActiveCurrencyAndAmount) Validate() error {
+	amount, c := a.Amount(), a.Currency()
+	if err := validate(amount, c, isoFractionDigits); err != nil {
+		return err
+	}
+	if digits(amount) > isoTotalDigits {
+		return ErrTooManyDigits
+	}
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface. errors as Validate.
+func (a ActiveCurrencyAndAmount) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := a.Validate(); err != nil {
+		return err
+	}
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "Ccy"}, Value: a.Currency().Code})
+	return e.EncodeElement(fixed(a.Amount(), a.Currency()), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface. errors with
+// money.ErrParse, money.ErrUnknownCurrency, or as Validate.
+func (a *ActiveCurrencyAndAmount) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return err
+	}
+	text = strings.TrimSpace(text)
+
+	var code string
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "Ccy" {
+			code = attr.Value
+		}
+	}
+	c, err := lookup(code)
+	if err != nil {
+		return err
+	}
+
+	if strings.ContainsAny(text, "eE") {
+		return &money.ErrParse{Input: text}
+	}
+	amount, err := decimal.NewFromString(text)
+	if err != nil {
+		return &money.ErrParse{Input: text, Err: err}
+	}
+
+	parsed := ActiveCurrencyAndAmount{money.Make(amount, c)}
+	if err := parsed.Validate(); err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// digits counts the significant digits of amount, ignoring trailing zeros
+// in the fraction, e.g. 3 for 12.30
+func digits(amount decimal.Decimal) int {
+	str := amount.Abs().String()
+	if strings.Contains(str, ".") {
+		str = strings.TrimRight(str, "0")
+	}
+	str = strings.TrimLeft(strings.Replace(str, ".", "", 1), "0")
+	if str == "" {
+		return 1
+	}
+	return len(str)
+}
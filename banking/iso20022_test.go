@@ -0,0 +1,116 @@
+package banking
+
+import (
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	"github.com/FoxComm/money"
+	. "github.com/FoxComm/money/currency"
+)
+
+func m(amount string, c Currency) money.Money {
+	return money.MustMakeFromString(amount, c)
+}
+
+func TestActiveCurrencyAndAmount(t *testing.T) {
+	var amounts = []struct {
+		money    money.Money
+		expected string
+	}{
+		{m("1234.56", USD), `<InstdAmt Ccy="USD">1234.56</InstdAmt>`},
+		{m("1234.5", USD), `<InstdAmt Ccy="USD">1234.50</InstdAmt>`},
+		{m("0", USD), `<InstdAmt Ccy="USD">0.00</InstdAmt>`},
+		{m("5000", XAF), `<InstdAmt Ccy="XAF">5000</InstdAmt>`},
+		{m("1.23456", XAU), `<InstdAmt Ccy="XAU">1.23456</InstdAmt>`},
+		{m("9999999999999999.99", USD), `<InstdAmt Ccy="USD">9999999999999999.99</InstdAmt>`},
+	}
+
+	for _, a := range amounts {
+		byt, err := xml.Marshal(named{ActiveCurrencyAndAmount{a.money}})
+		if err != nil || string(byt) != a.expected {
+			t.Errorf("xml.Marshal(%s) => (%s, %v), expected %s", a.money, byt, err, a.expected)
+		}
+
+		var actual named
+		if err := xml.Unmarshal([]byte(a.expected), &actual); err != nil || !actual.Equals(a.money) {
+			t.Errorf("xml.Unmarshal(%s) => (%s, %v), expected %s", a.expected, actual.Money, err, a.money)
+		}
+	}
+}
+
+// named is ActiveCurrencyAndAmount as an InstdAmt element
+type named struct {
+	ActiveCurrencyAndAmount
+}
+
+func (n named) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = "InstdAmt"
+	return n.ActiveCurrencyAndAmount.MarshalXML(e, start)
+}
+
+func TestActiveCurrencyAndAmountErrors(t *testing.T) {
+	var invalid = []struct {
+		money    money.Money
+		expected error
+	}{
+		{m("-1", USD), ErrNegativeAmount},
+		{m("1.005", USD), money.ErrPrecisionLoss},
+		{m("1.5", XAF), money.ErrPrecisionLoss},
+		{m("1.234567", XAU), money.ErrPrecisionLoss},
+		{m("99999999999999999.99", USD), ErrTooManyDigits},
+	}
+
+	for _, i := range invalid {
+		if err := (ActiveCurrencyAndAmount{i.money}).Validate(); err != i.expected {
+			t.Errorf("ActiveCurrencyAndAmount.Validate(%s) => %v, expected %v", i.money, err, i.expected)
+		}
+		if _, err := xml.Marshal(named{ActiveCurrencyAndAmount{i.money}}); !errors.Is(err, i.expected) {
+			t.Errorf("xml.Marshal(%s) => %v, expected %v", i.money, err, i.expected)
+		}
+	}
+
+	var parseErrors = []string{
+		`<InstdAmt Ccy="USD">1e3</InstdAmt>`,
+		`<InstdAmt Ccy="USD">1,234.56</InstdAmt>`,
+		`<InstdAmt Ccy="USD"></InstdAmt>`,
+	}
+	for _, input := range parseErrors {
+		var actual ActiveCurrencyAndAmount
+		var parse *money.ErrParse
+		if err := xml.Unmarshal([]byte(input), &actual); !errors.As(err, &parse) {
+			t.Errorf("xml.Unmarshal(%s) => %v, expected ErrParse", input, err)
+		}
+	}
+
+	var actual ActiveCurrencyAndAmount
+	var unknown *money.ErrUnknownCurrency
+	for _, input := range []string{`<InstdAmt Ccy="ZZZ">1</InstdAmt>`, `<InstdAmt>USD 1.00</InstdAmt>`} {
+		if err := xml.Unmarshal([]byte(input), &actual); !errors.As(err, &unknown) {
+			t.Errorf("xml.Unmarshal(%s) => %v, expected ErrUnknownCurrency", input, err)
+		}
+	}
+	if err := xml.Unmarshal([]byte(`<InstdAmt Ccy="USD">1.005</InstdAmt>`), &actual); err != money.ErrPrecisionLoss {
+		t.Errorf("xml.Unmarshal() => %v, expected ErrPrecisionLoss", err)
+	}
+}
+
+func TestDigits(t *testing.T) {
+	var amounts = []struct {
+		amount   string
+		expected int
+	}{
+		{"0", 1},
+		{"0.00", 1},
+		{"12.30", 3},
+		{"0.05", 1},
+		{"1000", 4},
+		{"-1234.56", 6},
+	}
+
+	for _, a := range amounts {
+		if actual := digits(m(a.amount, USD).Amount()); actual != a.expected {
+			t.Errorf("digits(%s) => %d, expected %d", a.amount, actual, a.expected)
+		}
+	}
+}
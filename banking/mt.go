@@ -0,0 +1,121 @@
+package banking
+
+import (
+	"strings"
+	"time"
+
+	"github.com/FoxComm/money"
+	"github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// mtAmountLength is the most characters of an MT amount, 15d, including
+	// the decimal comma
+	mtAmountLength = 15
+
+	// mtDate is the value date layout of field 32A, YYMMDD
+	mtDate = "060102"
+)
+
+// FormatAmount formats m as a SWIFT MT amount: a decimal comma, which is
+// always present, and no grouping, e.g. "1234,56" or "1000,". Amounts are
+// padded to the currency's minor unit. errors with ErrNegativeAmount,
+// ErrTooManyDigits, or money.ErrPrecisionLoss for more fraction digits than
+// the currency allows.
+func FormatAmount(m money.Money) (string, error) {
+	amount, c := m.Amount(), m.Currency()
+	if err := validate(amount, c, mtAmountLength); err != nil {
+		return "", err
+	}
+
+	str := fixed(amount, c)
+	if !strings.Contains(str, ".") {
+		str += "."
+	}
+	str = strings.Replace(str, ".", ",", 1)
+	if len(str) > mtAmountLength {
+		return "", ErrTooManyDigits
+	}
+	return str, nil
+}
+
+// ParseAmount parses a SWIFT MT amount in currency c, e.g. "1234,56". errors
+// with money.ErrParse for anything but digits and exactly one comma, with at
+// least one digit before it, or as FormatAmount.
+func ParseAmount(str string, c currency.Currency) (money.Money, error) {
+	comma := strings.IndexByte(str, ',')
+	if comma < 1 || strings.Count(str, ",") != 1 || strings.Trim(str, "0123456789,") != "" {
+		return money.Money{}, &money.ErrParse{Input: str}
+	}
+	if len(str) > mtAmountLength {
+		return money.Money{}, ErrTooManyDigits
+	}
+
+	amount, err := decimal.NewFromString(strings.Replace(strings.TrimSuffix(str, ","), ",", ".", 1))
+	if err != nil {
+		return money.Money{}, &money.ErrParse{Input: str, Err: err}
+	}
+	if err := validate(amount, c, mtAmountLength); err != nil {
+		return money.Money{}, err
+	}
+	return money.Make(amount, c), nil
+}
+
+// Field32A is SWIFT MT field 32A, Value Date/Currency/Interbank Settled
+// Amount, e.g. :32A:230615EUR1234,56
+type Field32A struct {
+	ValueDate time.Time
+	Amount    money.Money
+}
+
+// ParseField32A parses the content of field 32A, with or without its
+// ":32A:" tag. errors with money.ErrParse, money.ErrUnknownCurrency, or as
+// ParseAmount.
+func ParseField32A(str string) (Field32A, error) {
+	content := strings.TrimPrefix(str, ":32A:")
+	if len(content) < 11 {
+		return Field32A{}, &money.ErrParse{Input: str}
+	}
+
+	date, err := time.Parse(mtDate, content[:6])
+	if err != nil {
+		return Field32A{}, &money.ErrParse{Input: str, Err: err}
+	}
+	c, err := lookup(content[6:9])
+	if err != nil {
+		return Field32A{}, err
+	}
+	amount, err := ParseAmount(content[9:], c)
+	if err != nil {
+		return Field32A{}, err
+	}
+	return Field32A{date, amount}, nil
+}
+
+// Format formats the field with its tag, e.g. ":32A:230615EUR1234,56".
+// errors as FormatAmount.
+func (f Field32A) Format() (string, error) {
+	content, err := f.MarshalText()
+	if err != nil {
+		return "", err
+	}
+	return ":32A:" + string(content), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface with the
+// content of the field, without its tag, e.g. "230615EUR1234,56".
+func (f Field32A) MarshalText() ([]byte, error) {
+	amount, err := FormatAmount(f.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(f.ValueDate.Format(mtDate) + f.Amount.Currency().Code + amount), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, see
+// ParseField32A.
+func (f *Field32A) UnmarshalText(text []byte) (err error) {
+	*f, err = ParseField32A(string(text))
+	return
+}
@@ -0,0 +1,124 @@
+package banking
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/FoxComm/money"
+	. "github.com/FoxComm/money/currency"
+)
+
+func TestFormatAmount(t *testing.T) {
+	var amounts = []struct {
+		money    money.Money
+		expected string
+	}{
+		{m("1234.56", USD), "1234,56"},
+		{m("1234.5", USD), "1234,50"},
+		{m("1000000", USD), "1000000,00"},
+		{m("0", USD), "0,00"},
+		{m("5000", XAF), "5000,"},
+		{m("1.5", XAU), "1,5"},
+		{m("123456789012.34", USD), "123456789012,34"},
+	}
+
+	for _, a := range amounts {
+		if actual, err := FormatAmount(a.money); err != nil || actual != a.expected {
+			t.Errorf("FormatAmount(%s) => (%s, %v), expected %s", a.money, actual, err, a.expected)
+		}
+	}
+
+	var invalid = []struct {
+		money    money.Money
+		expected error
+	}{
+		{m("-1", USD), ErrNegativeAmount},
+		{m("1.005", USD), money.ErrPrecisionLoss},
+		{m("1234567890123.45", USD), ErrTooManyDigits},
+	}
+	for _, i := range invalid {
+		if _, err := FormatAmount(i.money); err != i.expected {
+			t.Errorf("FormatAmount(%s) => %v, expected %v", i.money, err, i.expected)
+		}
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	var amounts = []struct {
+		str      string
+		currency Currency
+		expected money.Money
+	}{
+		{"1234,56", USD, m("1234.56", USD)},
+		{"1234,5", USD, m("1234.5", USD)},
+		{"1000,", USD, m("1000", USD)},
+		{"0,", USD, m("0", USD)},
+		{"5000,", XAF, m("5000", XAF)},
+		{"00012,30", USD, m("12.3", USD)},
+	}
+
+	for _, a := range amounts {
+		if actual, err := ParseAmount(a.str, a.currency); err != nil || !actual.Equals(a.expected) {
+			t.Errorf("ParseAmount(%s) => (%s, %v), expected %s", a.str, actual, err, a.expected)
+		}
+	}
+
+	for _, str := range []string{"1234.56", "1234", ",56", "1,234,56", "-1,00", "1 234,56", ""} {
+		var parse *money.ErrParse
+		if _, err := ParseAmount(str, USD); !errors.As(err, &parse) {
+			t.Errorf("ParseAmount(%q) => %v, expected ErrParse", str, err)
+		}
+	}
+	if _, err := ParseAmount("1,005", USD); err != money.ErrPrecisionLoss {
+		t.Errorf("ParseAmount(1,005) => %v, expected ErrPrecisionLoss", err)
+	}
+	if _, err := ParseAmount("1,5", XAF); err != money.ErrPrecisionLoss {
+		t.Errorf("ParseAmount(1,5) => %v, expected ErrPrecisionLoss", err)
+	}
+	if _, err := ParseAmount("1234567890123,45", USD); err != ErrTooManyDigits {
+		t.Errorf("ParseAmount() => %v, expected ErrTooManyDigits", err)
+	}
+}
+
+func TestField32A(t *testing.T) {
+	expected := Field32A{time.Date(2023, 6, 15, 0, 0, 0, 0, time.UTC), m("1234.56", USD)}
+
+	formatted, err := expected.Format()
+	if err != nil || formatted != ":32A:230615USD1234,56" {
+		t.Errorf("Field32A.Format() => (%s, %v), expected :32A:230615USD1234,56", formatted, err)
+	}
+
+	for _, str := range []string{":32A:230615USD1234,56", "230615USD1234,56"} {
+		actual, err := ParseField32A(str)
+		if err != nil || !actual.ValueDate.Equal(expected.ValueDate) || !actual.Amount.Equals(expected.Amount) {
+			t.Errorf("ParseField32A(%s) => (%+v, %v), expected %+v", str, actual, err, expected)
+		}
+	}
+
+	var f Field32A
+	if err := f.UnmarshalText([]byte("991231XAF5000,")); err != nil || f.ValueDate.Year() != 1999 || !f.Amount.Equals(m("5000", XAF)) {
+		t.Errorf("Field32A.UnmarshalText() => (%+v, %v), expected 1999-12-31 XAF 5000", f, err)
+	}
+	if byt, err := f.MarshalText(); err != nil || string(byt) != "991231XAF5000," {
+		t.Errorf("Field32A.MarshalText() => (%s, %v), expected 991231XAF5000,", byt, err)
+	}
+}
+
+func TestField32AErrors(t *testing.T) {
+	var parse *money.ErrParse
+	for _, str := range []string{":32A:", "230615USD", "231315USD1,00", "230615USD1.00"} {
+		if _, err := ParseField32A(str); !errors.As(err, &parse) {
+			t.Errorf("ParseField32A(%s) => %v, expected ErrParse", str, err)
+		}
+	}
+
+	var unknown *money.ErrUnknownCurrency
+	if _, err := ParseField32A("230615ZZZ1,00"); !errors.As(err, &unknown) {
+		t.Errorf("ParseField32A() => %v, expected ErrUnknownCurrency", err)
+	}
+
+	if _, err := (Field32A{time.Now(), m("-1", USD)}).Format(); err != ErrNegativeAmount {
+		t.Errorf("Field32A.Format() => %v, expected ErrNegativeAmount", err)
+	}
+}
@@ -0,0 +1,146 @@
+package money
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+)
+
+// binaryVersion is the version of the binary format written by MarshalBinary
+const binaryVersion = 1
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface, and so
+// gob encoding. The format is stable across releases; version 1 is:
+//
+//	version  byte     1
+//	currency uvarint  ISO 4217 numeric code, e.g. 840 for USD
+//	exponent varint   power of ten of the amount, e.g. -2
+//	amount   varint   the amount scaled by -exponent, e.g. 1050
+//
+// so USD 10.50 is the 6 bytes 01 c8 06 03 b4 10. Varints are those of
+// encoding/binary, and amounts which don't fit an int64 continue the same
+// zigzag base 128 encoding for as many bytes as needed. errors with
+// ErrUnknownCurrency for currencies without a numeric code.
+func (m Money) MarshalBinary() ([]byte, error) {
+	if m.currency.Number <= 0 {
+		return nil, &ErrUnknownCurrency{m.currency.Code}
+	}
+
+	buf := make([]byte, 0, 16)
+	buf = append(buf, binaryVersion)
+	buf = binary.AppendUvarint(buf, uint64(m.currency.Number))
+	buf = binary.AppendVarint(buf, int64(m.amount.Exponent()))
+	return appendBigVarint(buf, m.amount.Coefficient()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface, and
+// so gob decoding. errors with ErrInvalidEncoding, or ErrUnknownCurrency for
+// numeric codes which aren't in currency.Table.
+func (m *Money) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != binaryVersion {
+		return ErrInvalidEncoding
+	}
+	data = data[1:]
+
+	number, n := binary.Uvarint(data)
+	if n <= 0 {
+		return ErrInvalidEncoding
+	}
+	data = data[n:]
+
+	exp, n := binary.Varint(data)
+	if n <= 0 || exp != int64(int32(exp)) {
+		return ErrInvalidEncoding
+	}
+	data = data[n:]
+
+	coef, n := bigVarint(data)
+	if n <= 0 || n != len(data) {
+		return ErrInvalidEncoding
+	}
+
+	c, ok := currency.ByNumber(int(number))
+	if !ok {
+		return &ErrUnknownCurrency{fmt.Sprintf("%03d", number)}
+	}
+	*m = Make(decimal.NewFromBigInt(coef, int32(exp)), c)
+	return nil
+}
+
+// appendBigVarint appends the zigzag base 128 encoding of i, which is the
+// same as binary.AppendVarint for i that fit an int64
+func appendBigVarint(buf []byte, i *big.Int) []byte {
+	if i.IsInt64() {
+		return binary.AppendVarint(buf, i.Int64())
+	}
+
+	// zigzag: 2|i| for i >= 0, 2|i| - 1 for i < 0
+	z := new(big.Int).Lsh(new(big.Int).Abs(i), 1)
+	if i.Sign() < 0 {
+		z.Sub(z, big.NewInt(1))
+	}
+
+	low := big.NewInt(0x7f)
+	group := new(big.Int)
+	for z.Cmp(low) > 0 {
+		buf = append(buf, byte(group.And(z, low).Uint64())|0x80)
+		z.Rsh(z, 7)
+	}
+	return append(buf, byte(z.Uint64()))
+}
+
+// bigVarint decodes appendBigVarint, returning the number of bytes read, or
+// n <= 0 if buf is too short
+func bigVarint(buf []byte) (*big.Int, int) {
+	if i, n := binary.Varint(buf); n > 0 {
+		return big.NewInt(i), n
+	}
+
+	z := new(big.Int)
+	for n, b := range buf {
+		z.Or(z, new(big.Int).Lsh(big.NewInt(int64(b&0x7f)), uint(7*n)))
+		if b < 0x80 {
+			i := new(big.Int).Rsh(z, 1)
+			if z.Bit(0) == 1 {
+				i.Neg(i).Sub(i, big.NewInt(1))
+			}
+			return i, n + 1
+		}
+	}
+	return nil, 0
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface, in the
+// format of Money.
+func (e Exact) MarshalBinary() ([]byte, error) {
+	return e.money.MarshalBinary()
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (e *Exact) UnmarshalBinary(data []byte) (err error) {
+	var m Money
+	if err = m.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	*e, err = m.ToExact()
+	return
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface, in the
+// format of Money.
+func (c Compact) MarshalBinary() ([]byte, error) {
+	return c.Money().MarshalBinary()
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (c *Compact) UnmarshalBinary(data []byte) (err error) {
+	var m Money
+	if err = m.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	*c, err = m.ToCompact()
+	return
+}
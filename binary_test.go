@@ -0,0 +1,128 @@
+package money
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	. "github.com/FoxComm/money/currency"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	var vectors = []struct {
+		money    Money
+		expected string
+	}{
+		{Make(d("10.50"), USD), "01c80603b410"},
+		{Make(d("-0.05"), USD), "01c8060309"},
+		{Make(d("0"), USD), "01c8060000"},
+		{Make(d("5000"), XAF), "01b60700904e"},
+		{Make(d("1.5"), XAU), "01bf07011e"},
+		{Make(d("9223372036854775807"), USD), "01c80600feffffffffffffffff01"},
+		{Make(d("9223372036854775808"), USD), "01c8060080808080808080808002"},
+		{Make(d("-9223372036854775809"), USD), "01c8060081808080808080808002"},
+	}
+
+	for _, v := range vectors {
+		byt, err := v.money.MarshalBinary()
+		if err != nil || hex.EncodeToString(byt) != v.expected {
+			t.Errorf("Money.MarshalBinary(%s) => (%x, %v), expected %s", v.money, byt, err, v.expected)
+		}
+
+		var m Money
+		if err := m.UnmarshalBinary(byt); err != nil || !m.Equals(v.money) {
+			t.Errorf("Money.UnmarshalBinary(%x) => (%s, %v), expected %s", byt, m, err, v.money)
+		}
+	}
+}
+
+func TestMarshalBinaryRoundTrip(t *testing.T) {
+	monies := []Money{
+		Make(d("123456789012345678901234567890.123456789"), USD),
+		Make(d("-123456789012345678901234567890.123456789"), USD),
+		Make(d("0.0000000000000000000001"), XAU),
+	}
+
+	for _, expected := range monies {
+		byt, err := expected.MarshalBinary()
+		if err != nil {
+			t.Errorf("Money.MarshalBinary(%s) => unexpected error %s", expected, err)
+			continue
+		}
+		var m Money
+		if err := m.UnmarshalBinary(byt); err != nil || !m.Equals(expected) {
+			t.Errorf("Money.UnmarshalBinary(%x) => (%s, %v), expected %s", byt, m, err, expected)
+		}
+	}
+}
+
+func TestUnmarshalBinaryErrors(t *testing.T) {
+	var invalid = []string{
+		"",
+		"02c80603b410",
+		"01",
+		"01c8",
+		"01c806",
+		"01c80603",
+		"01c80603b4",
+		"01c80603b41000",
+		"01c806ffffffff7f00",
+	}
+
+	for _, input := range invalid {
+		data, _ := hex.DecodeString(input)
+		var m Money
+		if err := m.UnmarshalBinary(data); err != ErrInvalidEncoding {
+			t.Errorf("Money.UnmarshalBinary(%s) => %v, expected ErrInvalidEncoding", input, err)
+		}
+	}
+
+	var m Money
+	var unknown *ErrUnknownCurrency
+	if err := m.UnmarshalBinary([]byte{1, 1, 0, 0}); !errors.As(err, &unknown) || unknown.Code != "001" {
+		t.Errorf("Money.UnmarshalBinary() => %v, expected ErrUnknownCurrency 001", err)
+	}
+
+	custom := Currency{Code: "FXC", Minor: 100, Kind: Custom}
+	if _, err := Make(d("1"), custom).MarshalBinary(); !errors.As(err, &unknown) {
+		t.Errorf("Money.MarshalBinary() => %v, expected ErrUnknownCurrency", err)
+	}
+}
+
+func TestGob(t *testing.T) {
+	type cart struct {
+		Total    Money
+		Shipping Exact
+		Items    []Compact
+	}
+	expected := cart{Make(d("10.50"), USD), MakeExactMinor(500, USD), []Compact{MakeCompact(250, USD), MakeCompact(800, USD)}}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(expected); err != nil {
+		t.Fatalf("gob.Encode() => unexpected error %s", err)
+	}
+
+	var actual cart
+	if err := gob.NewDecoder(&buf).Decode(&actual); err != nil {
+		t.Fatalf("gob.Decode() => unexpected error %s", err)
+	}
+	if !actual.Total.Equals(expected.Total) || !actual.Shipping.Equals(expected.Shipping) ||
+		len(actual.Items) != 2 || !actual.Items[1].Equals(expected.Items[1]) {
+		t.Errorf("gob.Decode() => %+v, expected %+v", actual, expected)
+	}
+}
+
+func TestUnmarshalBinaryExact(t *testing.T) {
+	byt, _ := Make(d("10.505"), USD).MarshalBinary()
+
+	var e Exact
+	if err := e.UnmarshalBinary(byt); err != ErrPrecisionLoss {
+		t.Errorf("Exact.UnmarshalBinary() => %v, expected ErrPrecisionLoss", err)
+	}
+	var c Compact
+	if err := c.UnmarshalBinary(byt); err != ErrPrecisionLoss {
+		t.Errorf("Compact.UnmarshalBinary() => %v, expected ErrPrecisionLoss", err)
+	}
+}
@@ -0,0 +1,89 @@
+package money
+
+import "github.com/shopspring/decimal"
+
+// Calc chains arithmetic on Money. The first error stops the chain and is
+// reported by Result, so an expression reads left to right:
+//
+//	total, err := money.Chain(subtotal).Add(shipping).Sub(discount).Result()
+type Calc struct {
+	money Money
+	err   error
+}
+
+// Chain starts a calculation with m
+func Chain(m Money) Calc {
+	return Calc{money: m}
+}
+
+// Result returns the calculated Money, or the first error of the chain
+func (c Calc) Result() (Money, error) {
+	if c.err != nil {
+		return Zero(c.money.currency), c.err
+	}
+	return c.money, nil
+}
+
+// Err returns the first error of the chain, if any
+func (c Calc) Err() error {
+	return c.err
+}
+
+// Add adds other. errors if currency is different.
+func (c Calc) Add(other Money) Calc {
+	if c.err != nil {
+		return c
+	}
+	m, err := c.money.Add(other)
+	return Calc{m, err}
+}
+
+// Sub subtracts other. errors if currency is different.
+func (c Calc) Sub(other Money) Calc {
+	if c.err != nil {
+		return c
+	}
+	m, err := c.money.Sub(other)
+	return Calc{m, err}
+}
+
+// Mul multiplies the amount by factor, e.g. a quantity or tax rate
+func (c Calc) Mul(factor decimal.Decimal) Calc {
+	if c.err != nil {
+		return c
+	}
+	return Calc{money: Make(c.money.amount.Mul(factor), c.money.currency)}
+}
+
+// Div divides the amount by divisor. errors with ErrDivisionByZero if
+// divisor is zero.
+func (c Calc) Div(divisor decimal.Decimal) Calc {
+	if c.err != nil {
+		return c
+	}
+	if divisor.Sign() == 0 {
+		return Calc{c.money, ErrDivisionByZero}
+	}
+	return Calc{money: Make(c.money.amount.Div(divisor), c.money.currency)}
+}
+
+// Negate negates the sign of the amount
+func (c Calc) Negate() Calc {
+	if c.err != nil {
+		return c
+	}
+	return Calc{money: c.money.Negate()}
+}
+
+// Round rounds the amount half away from zero to the currency's minor unit
+func (c Calc) Round() Calc {
+	return c.RoundWith(RoundHalfUp)
+}
+
+// RoundWith rounds the amount to the currency's minor unit using r
+func (c Calc) RoundWith(r Rounding) Calc {
+	if c.err != nil {
+		return c
+	}
+	return Calc{money: c.money.RoundWith(r)}
+}
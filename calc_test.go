@@ -0,0 +1,47 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/FoxComm/money/currency"
+)
+
+func TestCalc(t *testing.T) {
+	subtotal := Make(d("100"), USD)
+	discount := Make(d("15"), USD)
+
+	total, err := Chain(subtotal).Sub(discount).Mul(d("1.0725")).Round().Result()
+	if err != nil {
+		t.Errorf("Calc.Result() => unexpected error %s", err)
+	} else if expected := Make(d("91.16"), USD); !total.Equals(expected) {
+		t.Errorf("Calc.Result() => %s, expected %s", total, expected)
+	}
+
+	split, err := Chain(subtotal).Div(d("3")).RoundWith(RoundDown).Result()
+	if err != nil || !split.Equals(Make(d("33.33"), USD)) {
+		t.Errorf("Calc.Result() => (%s, %v), expected USD 33.33", split, err)
+	}
+
+	if negated := Chain(subtotal).Negate().MustResult(); !negated.Equals(Make(d("-100"), USD)) {
+		t.Errorf("Calc.MustResult() => %s, expected USD -100.00", negated)
+	}
+}
+
+func TestCalcFirstError(t *testing.T) {
+	calc := Chain(Make(d("100"), USD)).Add(Make(d("1"), MXN)).Div(d("0"))
+
+	var diffErr *ErrDifferentCurrency
+	if !errors.As(calc.Err(), &diffErr) {
+		t.Errorf("Calc.Err() => %v, expected ErrDifferentCurrency", calc.Err())
+	}
+
+	zero, err := calc.Add(Make(d("1"), USD)).Result()
+	if !errors.As(err, &diffErr) || !zero.IsZero() {
+		t.Errorf("Calc.Result() => (%s, %v), expected zero and ErrDifferentCurrency", zero, err)
+	}
+
+	if _, err := Chain(Make(d("100"), USD)).Div(d("0")).Add(Make(d("1"), MXN)).Result(); err != ErrDivisionByZero {
+		t.Errorf("Calc.Result() => %v, expected ErrDivisionByZero", err)
+	}
+}
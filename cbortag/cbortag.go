@@ -0,0 +1,73 @@
+// Package cbortag encodes money.Money in CBOR (RFC 8949) with
+// github.com/fxamacker/cbor as tag Tag enclosing an array of the ISO 4217
+// numeric currency, the amount's exponent, and its mantissa as an integer,
+// or a bignum if it doesn't fit 64 bits. USD 10.50 is
+//
+//	4217([840, -2, 1050]) = d9 10 79 83 19 03 48 21 19 04 1a
+//
+// Tag is not registered with IANA; it's private to systems exchanging Money.
+package cbortag
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/FoxComm/money"
+	"github.com/FoxComm/money/currency"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/shopspring/decimal"
+)
+
+// Tag is the CBOR tag number of Money
+const Tag = 4217
+
+// Money maps money.Money to CBOR tag Tag
+type Money struct {
+	money.Money
+}
+
+// body is the content of Tag
+type body struct {
+	_        struct{} `cbor:",toarray"`
+	Currency uint64
+	Exponent int64
+	Mantissa big.Int
+}
+
+// MarshalCBOR implements the cbor.Marshaler interface. errors with
+// money.ErrUnknownCurrency for currencies without a numeric code.
+func (m Money) MarshalCBOR() ([]byte, error) {
+	c := m.Currency()
+	if c.Number <= 0 {
+		return nil, &money.ErrUnknownCurrency{Code: c.Code}
+	}
+
+	amount := m.Amount()
+	return cbor.Marshal(cbor.Tag{Number: Tag, Content: body{
+		Currency: uint64(c.Number),
+		Exponent: int64(amount.Exponent()),
+		Mantissa: *amount.Coefficient(),
+	}})
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface. errors with
+// money.ErrInvalidEncoding for other tags or malformed content, or
+// money.ErrUnknownCurrency for numeric codes which aren't in currency.Table.
+func (m *Money) UnmarshalCBOR(data []byte) error {
+	var tag cbor.RawTag
+	if err := cbor.Unmarshal(data, &tag); err != nil || tag.Number != Tag {
+		return money.ErrInvalidEncoding
+	}
+
+	var b body
+	if err := cbor.Unmarshal(tag.Content, &b); err != nil || b.Exponent != int64(int32(b.Exponent)) {
+		return money.ErrInvalidEncoding
+	}
+
+	c, ok := currency.ByNumber(int(b.Currency))
+	if !ok {
+		return &money.ErrUnknownCurrency{Code: fmt.Sprintf("%03d", b.Currency)}
+	}
+	m.Money = money.Make(decimal.NewFromBigInt(&b.Mantissa, int32(b.Exponent)), c)
+	return nil
+}
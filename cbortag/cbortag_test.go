@@ -0,0 +1,90 @@
+package cbortag
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/FoxComm/money"
+	. "github.com/FoxComm/money/currency"
+	"github.com/fxamacker/cbor/v2"
+)
+
+func m(amount string, c Currency) money.Money {
+	return money.MustMakeFromString(amount, c)
+}
+
+func TestVectors(t *testing.T) {
+	var vectors = []struct {
+		money    money.Money
+		expected string
+	}{
+		{m("10.50", USD), "d91079831903482119041a"},
+		{m("-0.05", USD), "d91079831903482124"},
+		{m("5000", XAF), "d91079831903b600191388"},
+		{m("1.5", XAU), "d91079831903bf200f"},
+		{m("123456789012345678901234567890.5", USD), "d910798319034820c24d0f951a9fa3a286c94f0e766c39"},
+		{m("-123456789012345678901234567890.5", USD), "d910798319034820c34d0f951a9fa3a286c94f0e766c38"},
+	}
+
+	for _, v := range vectors {
+		byt, err := cbor.Marshal(Money{v.money})
+		if err != nil || hex.EncodeToString(byt) != v.expected {
+			t.Errorf("cbor.Marshal(%s) => (%x, %v), expected %s", v.money, byt, err, v.expected)
+		}
+
+		var actual Money
+		if err := cbor.Unmarshal(byt, &actual); err != nil || !actual.Equals(v.money) {
+			t.Errorf("cbor.Unmarshal(%x) => (%s, %v), expected %s", byt, actual.Money, err, v.money)
+		}
+	}
+}
+
+func TestStructs(t *testing.T) {
+	type event struct {
+		ID     string
+		Amount Money
+		Fees   []Money
+	}
+	expected := event{"evt-1", Money{m("10.50", USD)}, []Money{{m("0.30", USD)}, {m("0.01", USD)}}}
+
+	byt, err := cbor.Marshal(expected)
+	if err != nil {
+		t.Fatalf("cbor.Marshal() => unexpected error %s", err)
+	}
+
+	var actual event
+	if err := cbor.Unmarshal(byt, &actual); err != nil || actual.ID != expected.ID ||
+		!actual.Amount.Equals(expected.Amount.Money) || len(actual.Fees) != 2 || !actual.Fees[1].Equals(expected.Fees[1].Money) {
+		t.Errorf("cbor.Unmarshal() => (%+v, %v), expected %+v", actual, err, expected)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	var invalid = []string{
+		"c48221 19041a",
+		"d9107982190348 19041a",
+		"d910798319034821f5",
+		"d9107983190348 1b0000000100000000 01",
+	}
+
+	for _, input := range invalid {
+		data, _ := hex.DecodeString(strings.ReplaceAll(input, " ", ""))
+		var actual Money
+		if err := cbor.Unmarshal(data, &actual); !errors.Is(err, money.ErrInvalidEncoding) {
+			t.Errorf("cbor.Unmarshal(%s) => %v, expected ErrInvalidEncoding", input, err)
+		}
+	}
+
+	var unknown *money.ErrUnknownCurrency
+	var actual Money
+	if err := cbor.Unmarshal([]byte{0xd9, 0x10, 0x79, 0x83, 0x01, 0x00, 0x00}, &actual); !errors.As(err, &unknown) || unknown.Code != "001" {
+		t.Errorf("cbor.Unmarshal() => %v, expected ErrUnknownCurrency 001", err)
+	}
+
+	custom := Currency{Code: "FXC", Minor: 100, Kind: Custom}
+	if _, err := cbor.Marshal(Money{money.Make(m("1", USD).Amount(), custom)}); !errors.As(err, &unknown) {
+		t.Errorf("cbor.Marshal() => %v, expected ErrUnknownCurrency", err)
+	}
+}
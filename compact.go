@@ -0,0 +1,246 @@
+package money
+
+import (
+	"database/sql/driver"
+	"math"
+	"strconv"
+
+	"github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+)
+
+// Compact represents an amount as int64 minor units, e.g. cents for USD, as
+// an immutable value. It doesn't allocate for arithmetic or comparison, which
+// suits hot paths such as cart totals and sorting prices. Operations which
+// would overflow return ErrOverflow instead of wrapping.
+type Compact struct {
+	minor    int64
+	currency currency.Currency
+}
+
+// MakeCompact makes Compact from an amount in its minor unit, e.g. cents
+func MakeCompact(minor int64, c currency.Currency) Compact {
+	return Compact{minor, c}
+}
+
+// ToCompact converts to Compact. errors with ErrPrecisionLoss if the amount
+// has more decimals than the currency allows, or ErrOverflow if it doesn't
+// fit in an int64 of minor units.
+func (m Money) ToCompact() (Compact, error) {
+	e, err := m.ToExact()
+	if err != nil {
+		return Compact{0, m.currency}, err
+	}
+	return e.ToCompact()
+}
+
+// ToCompact converts to Compact. errors with ErrPrecisionLoss for fractional
+// amounts of currencies without minor units, or ErrOverflow if the amount
+// doesn't fit in an int64 of minor units.
+func (e Exact) ToCompact() (Compact, error) {
+	c := e.Currency()
+	minor := e.Amount().Shift(c.Digits())
+	if !minor.Equals(minor.Truncate(0)) {
+		return Compact{0, c}, ErrPrecisionLoss
+	}
+
+	i := minor.BigInt()
+	if !i.IsInt64() {
+		return Compact{0, c}, ErrOverflow
+	}
+	return Compact{i.Int64(), c}, nil
+}
+
+// Money converts to arbitrary precision Money
+func (c Compact) Money() Money {
+	return Make(decimal.New(c.minor, -c.currency.Digits()), c.currency)
+}
+
+// Exact converts to Exact, which never fails
+func (c Compact) Exact() Exact {
+	return MakeExactMinor(c.minor, c.currency)
+}
+
+// Minor is the monetary value in its minor unit
+func (c Compact) Minor() int64 {
+	return c.minor
+}
+
+// Amount is the monetary value in its major unit
+func (c Compact) Amount() decimal.Decimal {
+	return decimal.New(c.minor, -c.currency.Digits())
+}
+
+// Currency returns the set Currency
+func (c Compact) Currency() currency.Currency {
+	return c.currency
+}
+
+// String represents the amount in a currency context. e.g., for US: "USD 10.00"
+func (c Compact) String() string {
+	return c.currency.Code + " " + formatMinor(c.minor, int(c.currency.Digits()))
+}
+
+// Equals is true if other Compact is the same amount and currency
+func (c Compact) Equals(other Compact) bool {
+	return c.minor == other.minor && c.currency.Equals(other.currency)
+}
+
+// Cmp compares amounts. errors if currency is different.
+func (c Compact) Cmp(other Compact) (int, error) {
+	if !c.currency.Equals(other.currency) {
+		return 0, &ErrDifferentCurrency{c.currency, other.currency}
+	}
+	switch {
+	case c.minor < other.minor:
+		return -1, nil
+	case c.minor > other.minor:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// IsPositive returns true if the amount is > 0
+func (c Compact) IsPositive() bool {
+	return c.minor > 0
+}
+
+// IsNegative returns true if the amount is < 0
+func (c Compact) IsNegative() bool {
+	return c.minor < 0
+}
+
+// IsZero returns true if the amount is == 0
+func (c Compact) IsZero() bool {
+	return c.minor == 0
+}
+
+// Negate negates the sign of the amount. errors with ErrOverflow for the
+// smallest int64.
+func (c Compact) Negate() (Compact, error) {
+	if c.minor == math.MinInt64 {
+		return Compact{0, c.currency}, ErrOverflow
+	}
+	return Compact{-c.minor, c.currency}, nil
+}
+
+// Add adds amounts. errors if currency is different or the sum overflows.
+func (c Compact) Add(other Compact) (Compact, error) {
+	if !c.currency.Equals(other.currency) {
+		return Compact{0, c.currency}, &ErrDifferentCurrency{c.currency, other.currency}
+	}
+	sum := c.minor + other.minor
+	if (sum > c.minor) != (other.minor > 0) {
+		return Compact{0, c.currency}, ErrOverflow
+	}
+	return Compact{sum, c.currency}, nil
+}
+
+// Sub subtracts amounts. errors if currency is different or the difference
+// overflows.
+func (c Compact) Sub(other Compact) (Compact, error) {
+	if !c.currency.Equals(other.currency) {
+		return Compact{0, c.currency}, &ErrDifferentCurrency{c.currency, other.currency}
+	}
+	diff := c.minor - other.minor
+	if (diff < c.minor) != (other.minor > 0) {
+		return Compact{0, c.currency}, ErrOverflow
+	}
+	return Compact{diff, c.currency}, nil
+}
+
+// Mul multiplies the amount by a quantity. errors if the product overflows.
+func (c Compact) Mul(quantity int64) (Compact, error) {
+	if c.minor == 0 || quantity == 0 {
+		return Compact{0, c.currency}, nil
+	}
+	product := c.minor * quantity
+	if product/quantity != c.minor || (c.minor == -1 && quantity == math.MinInt64) ||
+		(quantity == -1 && c.minor == math.MinInt64) {
+		return Compact{0, c.currency}, ErrOverflow
+	}
+	return Compact{product, c.currency}, nil
+}
+
+// formatMinor formats minor units with the given number of decimals without
+// going through decimal.Decimal, e.g. (-5, 2) => "-0.05"
+func formatMinor(minor int64, digits int) string {
+	var buf [24]byte
+	s := strconv.AppendInt(buf[:0], minor, 10)
+	if digits == 0 {
+		return string(s)
+	}
+
+	neg := minor < 0
+	if neg {
+		s = s[1:]
+	}
+
+	out := make([]byte, 0, len(s)+digits+3)
+	if neg {
+		out = append(out, '-')
+	}
+	if len(s) <= digits {
+		out = append(out, '0', '.')
+		for i := len(s); i < digits; i++ {
+			out = append(out, '0')
+		}
+		out = append(out, s...)
+	} else {
+		out = append(out, s[:len(s)-digits]...)
+		out = append(out, '.')
+		out = append(out, s[len(s)-digits:]...)
+	}
+	return string(out)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (c *Compact) UnmarshalJSON(data []byte) (err error) {
+	if isNull(data) {
+		return nil
+	}
+
+	var m Money
+	if err = m.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*c, err = m.ToCompact()
+	return
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (c Compact) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + c.String() + `"`), nil
+}
+
+// Scan implements the sql.Scanner interface for database deserialization.
+func (c *Compact) Scan(value interface{}) (err error) {
+	var m Money
+	if err = m.Scan(value); err != nil {
+		return err
+	}
+	*c, err = m.ToCompact()
+	return
+}
+
+// Value implements the driver.Valuer interface for database serialization.
+func (c Compact) Value() (driver.Value, error) {
+	return c.String(), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for XML
+// deserialization.
+func (c *Compact) UnmarshalText(text []byte) (err error) {
+	var m Money
+	if err = m.UnmarshalText(text); err != nil {
+		return err
+	}
+	*c, err = m.ToCompact()
+	return
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for XML
+// serialization.
+func (c Compact) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
@@ -0,0 +1,150 @@
+package money
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/FoxComm/money/currency"
+)
+
+func TestToCompact(t *testing.T) {
+	var monies = []struct {
+		money    Money
+		expected int64
+		err      error
+	}{
+		{Make(d("10"), USD), 1000, nil},
+		{Make(d("-10.05"), USD), -1005, nil},
+		{Make(d("10.005"), USD), 0, ErrPrecisionLoss},
+		{Make(d("5000"), XAF), 5000, nil},
+		{Make(d("3"), XAU), 3, nil},
+		{Make(d("1.5"), XAU), 0, ErrPrecisionLoss},
+		{Make(d("92233720368547758.07"), USD), math.MaxInt64, nil},
+		{Make(d("92233720368547758.08"), USD), 0, ErrOverflow},
+		{Make(d("-92233720368547758.09"), USD), 0, ErrOverflow},
+	}
+
+	for _, m := range monies {
+		compact, err := m.money.ToCompact()
+		if err != m.err {
+			t.Errorf("Money.ToCompact(%s) => %v, expected error %v", m.money, err, m.err)
+		} else if err == nil && compact.Minor() != m.expected {
+			t.Errorf("Money.ToCompact(%s) => %d, expected %d", m.money, compact.Minor(), m.expected)
+		} else if err == nil && !compact.Money().Equals(m.money) {
+			t.Errorf("Compact.Money() => %s, expected %s", compact.Money(), m.money)
+		}
+	}
+}
+
+func TestCompactString(t *testing.T) {
+	var monies = []struct {
+		compact Compact
+	}{
+		{MakeCompact(0, USD)},
+		{MakeCompact(5, USD)},
+		{MakeCompact(-5, USD)},
+		{MakeCompact(1050, USD)},
+		{MakeCompact(-105500, USD)},
+		{MakeCompact(5000, XAF)},
+		{MakeCompact(math.MinInt64, USD)},
+	}
+
+	for _, m := range monies {
+		if actual, expected := m.compact.String(), m.compact.Money().String(); actual != expected {
+			t.Errorf("Compact.String() => %s, expected %s", actual, expected)
+		}
+	}
+}
+
+func TestCompactArithmetic(t *testing.T) {
+	var sums = []struct {
+		a, b     int64
+		expected int64
+		err      error
+	}{
+		{1000, 5, 1005, nil},
+		{1000, -1005, -5, nil},
+		{math.MaxInt64, 1, 0, ErrOverflow},
+		{math.MinInt64, -1, 0, ErrOverflow},
+		{math.MaxInt64, math.MinInt64, -1, nil},
+	}
+
+	for _, s := range sums {
+		sum, err := MakeCompact(s.a, USD).Add(MakeCompact(s.b, USD))
+		if err != s.err || sum.Minor() != s.expected {
+			t.Errorf("Compact.Add(%d, %d) => (%d, %v), expected (%d, %v)", s.a, s.b, sum.Minor(), err, s.expected, s.err)
+		}
+	}
+
+	if _, err := MakeCompact(math.MinInt64, USD).Sub(MakeCompact(1, USD)); err != ErrOverflow {
+		t.Errorf("Compact.Sub() => %v, expected ErrOverflow", err)
+	}
+
+	if diff, err := MakeCompact(5, USD).Sub(MakeCompact(10, USD)); err != nil || diff.Minor() != -5 {
+		t.Errorf("Compact.Sub() => (%d, %v), expected -5", diff.Minor(), err)
+	}
+
+	if _, err := MakeCompact(5, USD).Add(MakeCompact(5, MXN)); err == nil {
+		t.Errorf("Compact.Add() => expected ErrDifferentCurrency")
+	}
+
+	if product, err := MakeCompact(1999, USD).Mul(3); err != nil || product.Minor() != 5997 {
+		t.Errorf("Compact.Mul() => (%d, %v), expected 5997", product.Minor(), err)
+	}
+
+	if _, err := MakeCompact(math.MaxInt64/2+1, USD).Mul(2); err != ErrOverflow {
+		t.Errorf("Compact.Mul() => %v, expected ErrOverflow", err)
+	}
+
+	if _, err := MakeCompact(math.MinInt64, USD).Mul(-1); err != ErrOverflow {
+		t.Errorf("Compact.Mul() => %v, expected ErrOverflow", err)
+	}
+
+	if _, err := MakeCompact(math.MinInt64, USD).Negate(); err != ErrOverflow {
+		t.Errorf("Compact.Negate() => %v, expected ErrOverflow", err)
+	}
+
+	if cmp, err := MakeCompact(1, USD).Cmp(MakeCompact(2, USD)); err != nil || cmp != -1 {
+		t.Errorf("Compact.Cmp() => (%d, %v), expected -1", cmp, err)
+	}
+}
+
+func TestCompactSerialization(t *testing.T) {
+	c := MakeCompact(1050, USD)
+
+	byt, err := c.MarshalJSON()
+	if err != nil || string(byt) != `"USD 10.50"` {
+		t.Errorf("Compact.MarshalJSON() => (%s, %v), expected \"USD 10.50\"", byt, err)
+	}
+
+	var parsed Compact
+	if err := parsed.UnmarshalJSON(byt); err != nil || !parsed.Equals(c) {
+		t.Errorf("Compact.UnmarshalJSON() => (%s, %v), expected %s", parsed, err, c)
+	}
+
+	value, err := c.Value()
+	if err != nil || value.(string) != "USD 10.50" {
+		t.Errorf("Compact.Value() => (%v, %v), expected USD 10.50", value, err)
+	}
+
+	parsed = Compact{}
+	if err := parsed.Scan([]byte("USD 10.50")); err != nil || !parsed.Equals(c) {
+		t.Errorf("Compact.Scan() => (%s, %v), expected %s", parsed, err, c)
+	}
+}
+
+func BenchmarkCompactAdd(b *testing.B) {
+	total := MakeCompact(0, USD)
+	price := MakeCompact(1999, USD)
+	for i := 0; i < b.N; i++ {
+		total, _ = total.Add(price)
+	}
+}
+
+func BenchmarkMoneyAdd(b *testing.B) {
+	total := Zero(USD)
+	price := Make(d("19.99"), USD)
+	for i := 0; i < b.N; i++ {
+		total, _ = total.Add(price)
+	}
+}
@@ -0,0 +1,88 @@
+package money
+
+import "strings"
+
+// LessThan is true if the amount is < other. errors if currency is different.
+func (m Money) LessThan(other Money) (bool, error) {
+	cmp, err := m.Cmp(other)
+	return err == nil && cmp < 0, err
+}
+
+// LessThanOrEqual is true if the amount is <= other. errors if currency is
+// different.
+func (m Money) LessThanOrEqual(other Money) (bool, error) {
+	cmp, err := m.Cmp(other)
+	return err == nil && cmp <= 0, err
+}
+
+// GreaterThan is true if the amount is > other. errors if currency is
+// different.
+func (m Money) GreaterThan(other Money) (bool, error) {
+	cmp, err := m.Cmp(other)
+	return err == nil && cmp > 0, err
+}
+
+// GreaterThanOrEqual is true if the amount is >= other. errors if currency is
+// different.
+func (m Money) GreaterThanOrEqual(other Money) (bool, error) {
+	cmp, err := m.Cmp(other)
+	return err == nil && cmp >= 0, err
+}
+
+// Between is true if min <= amount <= max. errors if any currency is
+// different or with ErrInvalidBounds if min > max.
+func (m Money) Between(min, max Money) (bool, error) {
+	if err := checkBounds(min, max); err != nil {
+		return false, err
+	}
+	if below, err := m.LessThan(min); err != nil || below {
+		return false, err
+	}
+	return m.LessThanOrEqual(max)
+}
+
+// Clamp limits the amount to min <= amount <= max. errors if any currency is
+// different or with ErrInvalidBounds if min > max.
+func (m Money) Clamp(min, max Money) (Money, error) {
+	if err := checkBounds(min, max); err != nil {
+		return Zero(m.currency), err
+	}
+	if below, err := m.LessThan(min); err != nil {
+		return Zero(m.currency), err
+	} else if below {
+		return min, nil
+	}
+	if above, err := m.GreaterThan(max); err != nil {
+		return Zero(m.currency), err
+	} else if above {
+		return max, nil
+	}
+	return m, nil
+}
+
+func checkBounds(min, max Money) error {
+	cmp, err := min.Cmp(max)
+	if err != nil {
+		return err
+	}
+	if cmp > 0 {
+		return ErrInvalidBounds
+	}
+	return nil
+}
+
+// Compare orders monies by currency code, then amount. It never errors, so
+// it suits sorting mixed currencies, e.g. slices.SortFunc(prices, Compare).
+func Compare(a, b Money) int {
+	if cmp := strings.Compare(a.currency.Code, b.currency.Code); cmp != 0 {
+		return cmp
+	}
+	return a.amount.Cmp(b.amount)
+}
+
+// ByAmount implements sort.Interface ordering monies as Compare does
+type ByAmount []Money
+
+func (s ByAmount) Len() int           { return len(s) }
+func (s ByAmount) Less(i, j int) bool { return Compare(s[i], s[j]) < 0 }
+func (s ByAmount) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,105 @@
+package money
+
+import (
+	"sort"
+	"testing"
+
+	. "github.com/FoxComm/money/currency"
+)
+
+func TestOrdering(t *testing.T) {
+	var monies = []struct {
+		money, other     Money
+		lt, lte, gt, gte bool
+	}{
+		{Make(d("1"), USD), Make(d("2"), USD), true, true, false, false},
+		{Make(d("2"), USD), Make(d("2.00"), USD), false, true, false, true},
+		{Make(d("2.01"), USD), Make(d("2"), USD), false, false, true, true},
+		{Make(d("-1"), USD), Make(d("0"), USD), true, true, false, false},
+	}
+
+	for _, m := range monies {
+		if lt, err := m.money.LessThan(m.other); err != nil || lt != m.lt {
+			t.Errorf("%s.LessThan(%s) => (%t, %v), expected %t", m.money, m.other, lt, err, m.lt)
+		}
+		if lte, err := m.money.LessThanOrEqual(m.other); err != nil || lte != m.lte {
+			t.Errorf("%s.LessThanOrEqual(%s) => (%t, %v), expected %t", m.money, m.other, lte, err, m.lte)
+		}
+		if gt, err := m.money.GreaterThan(m.other); err != nil || gt != m.gt {
+			t.Errorf("%s.GreaterThan(%s) => (%t, %v), expected %t", m.money, m.other, gt, err, m.gt)
+		}
+		if gte, err := m.money.GreaterThanOrEqual(m.other); err != nil || gte != m.gte {
+			t.Errorf("%s.GreaterThanOrEqual(%s) => (%t, %v), expected %t", m.money, m.other, gte, err, m.gte)
+		}
+	}
+
+	usd, mxn := Make(d("1"), USD), Make(d("1"), MXN)
+	for name, f := range map[string]func(Money) (bool, error){
+		"LessThan":           usd.LessThan,
+		"LessThanOrEqual":    usd.LessThanOrEqual,
+		"GreaterThan":        usd.GreaterThan,
+		"GreaterThanOrEqual": usd.GreaterThanOrEqual,
+	} {
+		if ok, err := f(mxn); ok {
+			t.Errorf("Money.%s() => true, expected false on different currency", name)
+		} else if _, isDiff := err.(*ErrDifferentCurrency); !isDiff {
+			t.Errorf("Money.%s() => %v, expected ErrDifferentCurrency", name, err)
+		}
+	}
+}
+
+func TestBetweenAndClamp(t *testing.T) {
+	min, max := Make(d("10"), USD), Make(d("25"), USD)
+
+	var monies = []struct {
+		money   Money
+		between bool
+		clamped Money
+	}{
+		{Make(d("9.99"), USD), false, min},
+		{Make(d("10"), USD), true, Make(d("10"), USD)},
+		{Make(d("17.50"), USD), true, Make(d("17.50"), USD)},
+		{Make(d("25"), USD), true, Make(d("25"), USD)},
+		{Make(d("25.01"), USD), false, max},
+	}
+
+	for _, m := range monies {
+		if between, err := m.money.Between(min, max); err != nil || between != m.between {
+			t.Errorf("%s.Between() => (%t, %v), expected %t", m.money, between, err, m.between)
+		}
+		if clamped, err := m.money.Clamp(min, max); err != nil || !clamped.Equals(m.clamped) {
+			t.Errorf("%s.Clamp() => (%s, %v), expected %s", m.money, clamped, err, m.clamped)
+		}
+	}
+
+	if _, err := min.Between(max, min); err != ErrInvalidBounds {
+		t.Errorf("Money.Between() => %v, expected ErrInvalidBounds", err)
+	}
+	if _, err := min.Clamp(max, min); err != ErrInvalidBounds {
+		t.Errorf("Money.Clamp() => %v, expected ErrInvalidBounds", err)
+	}
+	if _, err := Make(d("1"), MXN).Clamp(min, max); err == nil {
+		t.Errorf("Money.Clamp() => expected ErrDifferentCurrency")
+	}
+	if _, err := min.Between(min, Make(d("1"), MXN)); err == nil {
+		t.Errorf("Money.Between() => expected ErrDifferentCurrency")
+	}
+}
+
+func TestSort(t *testing.T) {
+	monies := []Money{
+		Make(d("5"), USD),
+		Make(d("1"), MXN),
+		Make(d("-1"), USD),
+		Make(d("0.5"), USD),
+	}
+	expected := []string{"MXN 1.00", "USD -1.00", "USD 0.50", "USD 5.00"}
+
+	sort.Sort(ByAmount(monies))
+	for i, m := range monies {
+		if m.String() != expected[i] {
+			t.Errorf("sort.Sort(ByAmount) => %v, expected %v", monies, expected)
+			break
+		}
+	}
+}
@@ -3,20 +3,29 @@ package currency
 
 // Table holds all compiled currencies in a map ISO-NAME => value
 var Table = map[string]Currency{
-	"USD": USD,
+	"XTS": XTS,
+	"XXX": XXX,
 	"MXN": MXN,
 	"CNY": CNY,
+	"XAU": XAU,
+	"XAG": XAG,
+	"XPD": XPD,
+	"USD": USD,
 	"CAD": CAD,
+	"XAF": XAF,
+	"XPT": XPT,
+	"XDR": XDR,
 }
 
-// CNY is the Chinese Renminbi Yuan Currency
-var CNY = Currency{
-	Code:      "CNY",
-	Number:    156,
-	Symbol:    '¥',
+// USD is the United States Dollar Currency
+var USD = Currency{
+	Code:      "USD",
+	Number:    840,
+	Symbol:    '$',
 	Decimal:   '.',
 	Delimiter: ',',
 	Minor:     100,
+	Kind:      Fiat,
 }
 
 // CAD is the Canadian Dollar Currency
@@ -27,16 +36,62 @@ var CAD = Currency{
 	Decimal:   '.',
 	Delimiter: ',',
 	Minor:     100,
+	Kind:      Fiat,
 }
 
-// USD is the United States Dollar Currency
-var USD = Currency{
-	Code:      "USD",
-	Number:    840,
-	Symbol:    '$',
+// XAF is the Central African Cfa Franc Currency
+var XAF = Currency{
+	Code:      "XAF",
+	Number:    950,
+	Symbol:    '¤',
 	Decimal:   '.',
 	Delimiter: ',',
-	Minor:     100,
+	Minor:     1,
+	Kind:      Supranational,
+}
+
+// XPT is the Platinum (Troy Ounce) Currency
+var XPT = Currency{
+	Code:      "XPT",
+	Number:    962,
+	Symbol:    '¤',
+	Decimal:   '.',
+	Delimiter: ',',
+	Minor:     0,
+	Kind:      PreciousMetal,
+}
+
+// XDR is the Special Drawing Rights Currency
+var XDR = Currency{
+	Code:      "XDR",
+	Number:    960,
+	Symbol:    '¤',
+	Decimal:   '.',
+	Delimiter: ',',
+	Minor:     0,
+	Kind:      Fund,
+}
+
+// XTS is the Codes specifically reserved for testing purposes Currency
+var XTS = Currency{
+	Code:      "XTS",
+	Number:    963,
+	Symbol:    '¤',
+	Decimal:   '.',
+	Delimiter: ',',
+	Minor:     0,
+	Kind:      Testing,
+}
+
+// XXX is the No currency Currency
+var XXX = Currency{
+	Code:      "XXX",
+	Number:    999,
+	Symbol:    '¤',
+	Decimal:   '.',
+	Delimiter: ',',
+	Minor:     0,
+	Kind:      NoCurrency,
 }
 
 // MXN is the Mexican Peso Currency
@@ -47,4 +102,49 @@ var MXN = Currency{
 	Decimal:   '.',
 	Delimiter: ',',
 	Minor:     100,
+	Kind:      Fiat,
+}
+
+// CNY is the Chinese Renminbi Yuan Currency
+var CNY = Currency{
+	Code:      "CNY",
+	Number:    156,
+	Symbol:    '¥',
+	Decimal:   '.',
+	Delimiter: ',',
+	Minor:     100,
+	Kind:      Fiat,
+}
+
+// XAU is the Gold (Troy Ounce) Currency
+var XAU = Currency{
+	Code:      "XAU",
+	Number:    959,
+	Symbol:    '¤',
+	Decimal:   '.',
+	Delimiter: ',',
+	Minor:     0,
+	Kind:      PreciousMetal,
+}
+
+// XAG is the Silver (Troy Ounce) Currency
+var XAG = Currency{
+	Code:      "XAG",
+	Number:    961,
+	Symbol:    '¤',
+	Decimal:   '.',
+	Delimiter: ',',
+	Minor:     0,
+	Kind:      PreciousMetal,
+}
+
+// XPD is the Palladium (Troy Ounce) Currency
+var XPD = Currency{
+	Code:      "XPD",
+	Number:    964,
+	Symbol:    '¤',
+	Decimal:   '.',
+	Delimiter: ',',
+	Minor:     0,
+	Kind:      PreciousMetal,
 }
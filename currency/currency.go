@@ -2,7 +2,51 @@ package currency
 
 import "strings"
 
-// Currency represents fiat money
+// Kind classifies what a currency code stands for
+type Kind int
+
+const (
+	// Fiat is legal tender issued by a single country, e.g. USD
+	Fiat Kind = iota
+
+	// PreciousMetal is a commodity quoted per troy ounce, e.g. XAU
+	PreciousMetal
+
+	// Fund is a unit of account used by a fund or institution, e.g. XDR
+	Fund
+
+	// Supranational is legal tender shared by several countries, e.g. XAF
+	Supranational
+
+	// Testing is reserved by ISO 4217 for test purposes, i.e. XTS
+	Testing
+
+	// NoCurrency denotes a transaction where no currency is involved, i.e. XXX
+	NoCurrency
+
+	// Custom is a currency which isn't defined by ISO 4217
+	Custom
+)
+
+var kindNames = map[Kind]string{
+	Fiat:          "fiat",
+	PreciousMetal: "precious_metal",
+	Fund:          "fund",
+	Supranational: "supranational",
+	Testing:       "testing",
+	NoCurrency:    "no_currency",
+	Custom:        "custom",
+}
+
+// String is the snake_cased name of the kind, e.g. "precious_metal"
+func (k Kind) String() string {
+	if name, ok := kindNames[k]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// Currency represents fiat money or another ISO 4217 unit, see Kind
 type Currency struct {
 	// Code is the ISO 4217 alpha-3 name for the currency
 	Code string
@@ -19,8 +63,12 @@ type Currency struct {
 	// Delimiter is a rune which delimits integer thousands
 	Delimiter rune
 
-	// Minor is the 'exponent' of a currency unit. Assume base 10.
+	// Minor is the 'exponent' of a currency unit. Assume base 10. Zero means
+	// the currency has no minor unit (ISO 4217 "N.A."), e.g. XAU.
 	Minor int
+
+	// Kind classifies the currency, e.g. Fiat or PreciousMetal
+	Kind Kind
 }
 
 // String is the upcased ISO alpha-3 name
@@ -32,3 +80,44 @@ func (c Currency) String() string {
 func (c Currency) Equals(other Currency) bool {
 	return c == other
 }
+
+// HasMinorUnits is false for currencies without a defined minor unit, such as
+// precious metals and XDR. Their amounts are never rounded or padded.
+func (c Currency) HasMinorUnits() bool {
+	return c.Minor > 0
+}
+
+// Digits is the number of decimal places of the minor unit, e.g. 2 for USD
+// and 0 for JPY or any currency without minor units.
+func (c Currency) Digits() int32 {
+	var digits int32
+	for minor := c.Minor; minor > 1; minor /= 10 {
+		digits++
+	}
+	return digits
+}
+
+// ByKind returns the currencies of Table matching any of the given kinds
+func ByKind(kinds ...Kind) map[string]Currency {
+	filtered := make(map[string]Currency)
+	for code, c := range Table {
+		for _, k := range kinds {
+			if c.Kind == k {
+				filtered[code] = c
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// ByNumber returns the currency of Table with the given ISO 4217 numeric
+// code, e.g. 840 for USD
+func ByNumber(number int) (Currency, bool) {
+	for _, c := range Table {
+		if c.Number == number {
+			return c, true
+		}
+	}
+	return Currency{}, false
+}
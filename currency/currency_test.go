@@ -5,11 +5,6 @@ import (
 	"testing"
 )
 
-var (
-	// XTS is an ISO code reserved for use in testing
-	XTS = "XTS"
-)
-
 func TestString(t *testing.T) {
 	usd := USD
 	usd.Code = strings.ToLower(USD.Code)
@@ -40,3 +35,69 @@ func TestEquals(t *testing.T) {
 		}
 	}
 }
+
+func TestDigits(t *testing.T) {
+	var currencies = []struct {
+		currency      Currency
+		digits        int32
+		hasMinorUnits bool
+	}{
+		{USD, 2, true},
+		{XAF, 0, true},
+		{XAU, 0, false},
+		{XDR, 0, false},
+		{XTS, 0, false},
+	}
+
+	for _, c := range currencies {
+		if digits := c.currency.Digits(); digits != c.digits {
+			t.Errorf("%s.Digits() => %d, expected %d", c.currency, digits, c.digits)
+		}
+		if has := c.currency.HasMinorUnits(); has != c.hasMinorUnits {
+			t.Errorf("%s.HasMinorUnits() => %t, expected %t", c.currency, has, c.hasMinorUnits)
+		}
+	}
+}
+
+func TestByKind(t *testing.T) {
+	metals := ByKind(PreciousMetal)
+	for _, code := range []string{"XAU", "XAG", "XPT", "XPD"} {
+		if _, ok := metals[code]; !ok {
+			t.Errorf("ByKind(PreciousMetal) => missing %s", code)
+		}
+	}
+	if len(metals) != 4 {
+		t.Errorf("ByKind(PreciousMetal) => %d currencies, expected 4", len(metals))
+	}
+
+	mixed := ByKind(Testing, NoCurrency)
+	if len(mixed) != 2 || mixed["XTS"] != XTS || mixed["XXX"] != XXX {
+		t.Errorf("ByKind(Testing, NoCurrency) => %v, expected XTS and XXX", mixed)
+	}
+
+	for code, c := range ByKind(Fiat) {
+		if c.Kind != Fiat {
+			t.Errorf("ByKind(Fiat) => %s is %s", code, c.Kind)
+		}
+	}
+}
+
+func TestByNumber(t *testing.T) {
+	var numbers = []struct {
+		number   int
+		expected Currency
+		ok       bool
+	}{
+		{840, USD, true},
+		{959, XAU, true},
+		{999, XXX, true},
+		{0, Currency{}, false},
+		{1, Currency{}, false},
+	}
+
+	for _, n := range numbers {
+		if c, ok := ByNumber(n.number); ok != n.ok || !c.Equals(n.expected) {
+			t.Errorf("ByNumber(%d) => (%v, %t), expected (%v, %t)", n.number, c, ok, n.expected, n.ok)
+		}
+	}
+}
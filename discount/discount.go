@@ -0,0 +1,177 @@
+// Package discount applies promotions such as percent off, amount off, fixed
+// prices, buy X get Y and spend thresholds to order lines. Every discount is
+// allocated back onto the lines it came off, to the cent, so refunds and tax
+// can be calculated per line.
+package discount
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/FoxComm/money"
+	"github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrNoLines is returned when applying discounts to no lines at all
+	ErrNoLines = errors.New("no lines to discount")
+
+	// ErrInvalidDiscount is returned when a discount takes a negative amount
+	// or more than a line is still worth off a line
+	ErrInvalidDiscount = errors.New("invalid discount")
+)
+
+// Line is an order line which can be discounted
+type Line struct {
+	SKU       string
+	UnitPrice money.Money
+	Quantity  int64
+}
+
+// Discount takes an amount off lines
+type Discount interface {
+	// Priority orders discounts, lowest first; ties keep their given order
+	Priority() int
+
+	// Allocate returns the amount taken off each line, given what each line
+	// is still worth after discounts of lower priority. An amount never
+	// exceeds what its line is still worth.
+	Allocate(lines []Line, remaining []money.Money) ([]money.Money, error)
+}
+
+// Priorities of the discounts in this package. Unit level discounts go
+// before line level ones, which go before order level ones.
+const (
+	FixedPricePriority = 10 * (iota + 1)
+	BuyXGetYPriority
+	PercentOffPriority
+	AmountOffPriority
+	TieredPriority
+)
+
+// Applied is a discount and what it took off each line
+type Applied struct {
+	Discount Discount
+	Amount   money.Money
+	Lines    []money.Money
+}
+
+// Result is what all discounts took off the lines
+type Result struct {
+	// Total is the sum of all discounts
+	Total money.Money
+
+	// Lines holds the discount of every line
+	Lines []money.Money
+
+	// Applied holds every discount in the order it was applied
+	Applied []Applied
+}
+
+// Apply applies discounts to lines in order of priority. errors if the
+// currencies are different, with ErrNoLines or with ErrInvalidDiscount.
+func Apply(lines []Line, discounts ...Discount) (Result, error) {
+	if len(lines) == 0 {
+		return Result{}, ErrNoLines
+	}
+
+	cur := lines[0].UnitPrice.Currency()
+	remaining := make([]money.Money, len(lines))
+	result := Result{Total: money.Zero(cur), Lines: zeros(cur, len(lines))}
+
+	for i, line := range lines {
+		if !line.UnitPrice.Currency().Equals(cur) {
+			return Result{}, &money.ErrDifferentCurrency{Actual: line.UnitPrice.Currency(), Expected: cur}
+		}
+		remaining[i] = line.Value()
+	}
+
+	ordered := make([]Discount, len(discounts))
+	copy(ordered, discounts)
+	sort.SliceStable(ordered, func(a, b int) bool {
+		return ordered[a].Priority() < ordered[b].Priority()
+	})
+
+	for _, d := range ordered {
+		amounts, err := d.Allocate(lines, remaining)
+		if err != nil {
+			return Result{}, err
+		}
+
+		if len(amounts) != len(lines) {
+			return Result{}, ErrInvalidDiscount
+		}
+
+		applied := Applied{Discount: d, Amount: money.Zero(cur), Lines: amounts}
+		for i, amount := range amounts {
+			if remaining[i], err = remaining[i].Sub(amount); err != nil {
+				return Result{}, err
+			}
+			if amount.IsNegative() || remaining[i].IsNegative() {
+				return Result{}, ErrInvalidDiscount
+			}
+			applied.Amount = applied.Amount.MustAdd(amount)
+			result.Lines[i] = result.Lines[i].MustAdd(amount)
+		}
+		result.Total = result.Total.MustAdd(applied.Amount)
+		result.Applied = append(result.Applied, applied)
+	}
+	return result, nil
+}
+
+// Value is unit price × quantity
+func (l Line) Value() money.Money {
+	return money.Make(l.UnitPrice.Amount().Mul(decimal.New(l.Quantity, 0)), l.UnitPrice.Currency())
+}
+
+// eligible is true if the line's SKU is one of skus, or skus is empty
+func eligible(line Line, skus []string) bool {
+	if len(skus) == 0 {
+		return true
+	}
+	for _, sku := range skus {
+		if line.SKU == sku {
+			return true
+		}
+	}
+	return false
+}
+
+// spread allocates amount over the eligible lines in proportion to what
+// they're still worth
+func spread(amount money.Money, lines []Line, remaining []money.Money, skus []string) ([]money.Money, error) {
+	cur := amount.Currency()
+	amounts := zeros(cur, len(lines))
+	if amount.IsZero() {
+		return amounts, nil
+	}
+
+	weights := make([]decimal.Decimal, len(lines))
+	for i, line := range lines {
+		weights[i] = decimal.New(0, 0)
+		if eligible(line, skus) && remaining[i].IsPositive() {
+			weights[i] = remaining[i].Amount()
+		}
+	}
+	return amount.Allocate(weights...)
+}
+
+// worth is what the eligible lines are still worth
+func worth(cur currency.Currency, lines []Line, remaining []money.Money, skus []string) money.Money {
+	total := money.Zero(cur)
+	for i, line := range lines {
+		if eligible(line, skus) && remaining[i].IsPositive() {
+			total = total.MustAdd(remaining[i])
+		}
+	}
+	return total
+}
+
+func zeros(cur currency.Currency, n int) []money.Money {
+	amounts := make([]money.Money, n)
+	for i := range amounts {
+		amounts[i] = money.Zero(cur)
+	}
+	return amounts
+}
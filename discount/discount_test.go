@@ -0,0 +1,187 @@
+package discount
+
+import (
+	"testing"
+
+	"github.com/FoxComm/money"
+	. "github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+)
+
+func m(amount string) money.Money {
+	return money.MustMakeFromString(amount, USD)
+}
+
+func percent(p int64) money.Percent {
+	return money.MakePercent(decimal.New(p, 0))
+}
+
+func cart() []Line {
+	return []Line{
+		{SKU: "shirt", UnitPrice: m("20"), Quantity: 3},
+		{SKU: "socks", UnitPrice: m("5"), Quantity: 2},
+		{SKU: "hat", UnitPrice: m("15"), Quantity: 1},
+	}
+}
+
+func checkResult(t *testing.T, name string, result Result, total string, lines ...string) {
+	if !result.Total.Equals(m(total)) {
+		t.Errorf("%s => total %s, expected %s", name, result.Total, total)
+	}
+
+	sum := m("0")
+	for i, line := range result.Lines {
+		sum = sum.MustAdd(line)
+		if i < len(lines) && !line.Equals(m(lines[i])) {
+			t.Errorf("%s => line %d is %s, expected %s", name, i, line, lines[i])
+		}
+	}
+	if !sum.Amount().Equals(result.Total.Amount()) {
+		t.Errorf("%s => lines add up to %s, expected %s", name, sum, result.Total)
+	}
+}
+
+func TestPercentOff(t *testing.T) {
+	result, err := Apply(cart(), PercentOff{Percent: percent(15)})
+	if err != nil {
+		t.Fatalf("Apply() => unexpected error %s", err)
+	}
+	checkResult(t, "15% off", result, "12.75", "9.00", "1.50", "2.25")
+
+	result, err = Apply(cart(), PercentOff{Percent: money.MakePercent(decimal.RequireFromString("33.3")), SKUs: []string{"socks", "hat"}})
+	if err != nil {
+		t.Fatalf("Apply() => unexpected error %s", err)
+	}
+	checkResult(t, "33.3% off socks and hats", result, "8.33", "0", "3.33", "5.00")
+
+	result, err = Apply(cart(), PercentOff{Percent: percent(150), SKUs: []string{"hat"}})
+	if err != nil {
+		t.Fatalf("Apply() => unexpected error %s", err)
+	}
+	checkResult(t, "150% off hats", result, "15.00", "0", "0", "15.00")
+}
+
+func TestAmountOff(t *testing.T) {
+	result, err := Apply(cart(), AmountOff{Amount: m("10")})
+	if err != nil {
+		t.Fatalf("Apply() => unexpected error %s", err)
+	}
+	checkResult(t, "USD 10.00 off", result, "10.00", "7.06", "1.18", "1.76")
+
+	result, err = Apply(cart(), AmountOff{Amount: m("100"), SKUs: []string{"hat"}})
+	if err != nil {
+		t.Fatalf("Apply() => unexpected error %s", err)
+	}
+	checkResult(t, "USD 100.00 off hats", result, "15.00", "0", "0", "15.00")
+}
+
+func TestFixedPriceAndBuyXGetY(t *testing.T) {
+	result, err := Apply(cart(),
+		BuyXGetY{Buy: 2, Get: 1, Percent: percent(100), SKUs: []string{"shirt", "hat"}},
+		FixedPrice{Price: m("18"), SKUs: []string{"shirt"}},
+	)
+	if err != nil {
+		t.Fatalf("Apply() => unexpected error %s", err)
+	}
+
+	// shirts become 18.00 first, then the cheapest unit of 4 is free: a hat
+	checkResult(t, "fixed price then buy 2 get 1", result, "21.00", "6.00", "0", "15.00")
+	if len(result.Applied) != 2 || result.Applied[0].Discount.Priority() != FixedPricePriority {
+		t.Errorf("Apply() => %+v, expected FixedPrice applied first", result.Applied)
+	}
+
+	result, err = Apply(cart(), BuyXGetY{Buy: 1, Get: 1, Percent: percent(50)})
+	if err != nil {
+		t.Fatalf("Apply() => unexpected error %s", err)
+	}
+	// 6 units, 3 half price: both socks and the hat
+	checkResult(t, "buy 1 get 1 half price", result, "12.50", "0", "5.00", "7.50")
+
+	// shirts become 5.00 first, so the free one is worth 5.00, not 20.00
+	result, err = Apply(cart(),
+		BuyXGetY{Buy: 2, Get: 1, Percent: percent(100), SKUs: []string{"shirt"}},
+		FixedPrice{Price: m("5"), SKUs: []string{"shirt"}},
+	)
+	if err != nil {
+		t.Fatalf("Apply() => unexpected error %s", err)
+	}
+	checkResult(t, "fixed price 5 then buy 2 get 1", result, "50.00", "50.00", "0", "0")
+
+	result, err = Apply([]Line{{SKU: "pen", UnitPrice: m("0.15"), Quantity: 3}}, BuyXGetY{Buy: 1, Get: 1, Percent: percent(50)})
+	if err != nil {
+		t.Fatalf("Apply() => unexpected error %s", err)
+	}
+	// one of 3 pens half price: 0.45 / 3 × 50% = 0.075, rounded once
+	checkResult(t, "buy 1 get 1 half price pens", result, "0.08", "0.08")
+}
+
+func TestTieredAndCapped(t *testing.T) {
+	tiered := Tiered{Tiers: []Tier{
+		{Threshold: m("50"), Discount: AmountOff{Amount: m("5")}},
+		{Threshold: m("80"), Discount: AmountOff{Amount: m("15")}},
+		{Threshold: m("200"), Discount: AmountOff{Amount: m("50")}},
+	}}
+
+	result, err := Apply(cart(), tiered)
+	if err != nil {
+		t.Fatalf("Apply() => unexpected error %s", err)
+	}
+	checkResult(t, "spend 80 get 15 off", result, "15.00")
+
+	// 15% off first brings the order to 72.25, under the 80 tier
+	result, err = Apply(cart(), tiered, PercentOff{Percent: percent(15)})
+	if err != nil {
+		t.Fatalf("Apply() => unexpected error %s", err)
+	}
+	checkResult(t, "15% off then spend 50 get 5 off", result, "17.75")
+
+	result, err = Apply(cart(), Capped{Discount: PercentOff{Percent: percent(50)}, Max: m("20")})
+	if err != nil {
+		t.Fatalf("Apply() => unexpected error %s", err)
+	}
+	checkResult(t, "50% off up to 20", result, "20.00", "14.12", "2.35", "3.53")
+
+	result, err = Apply(cart(), Capped{Discount: PercentOff{Percent: percent(50)}, Max: m("-1")})
+	if err != nil {
+		t.Fatalf("Apply() => unexpected error %s", err)
+	}
+	checkResult(t, "50% off up to -1", result, "0", "0", "0", "0")
+}
+
+func TestApplyErrors(t *testing.T) {
+	if _, err := Apply(nil, PercentOff{Percent: percent(10)}); err != ErrNoLines {
+		t.Errorf("Apply() => %v, expected ErrNoLines", err)
+	}
+
+	lines := cart()
+	lines[1].UnitPrice = money.MustMakeFromString("5", CAD)
+	if _, err := Apply(lines); err == nil {
+		t.Errorf("Apply() => expected ErrDifferentCurrency")
+	}
+
+	if _, err := Apply(cart(), AmountOff{Amount: money.MustMakeFromString("5", CAD)}); err == nil {
+		t.Errorf("Apply() => expected ErrDifferentCurrency")
+	}
+
+	var invalid = []Discount{
+		fixed{m("-1"), m("0"), m("0")},
+		fixed{m("0"), m("10.01"), m("0")},
+		fixed{m("0"), m("0")},
+	}
+	for _, d := range invalid {
+		if _, err := Apply(cart(), d); err != ErrInvalidDiscount {
+			t.Errorf("Apply(%v) => %v, expected ErrInvalidDiscount", d, err)
+		}
+	}
+}
+
+// fixed takes fixed amounts off the lines, whatever they're worth
+type fixed []money.Money
+
+func (d fixed) Priority() int {
+	return 0
+}
+
+func (d fixed) Allocate([]Line, []money.Money) ([]money.Money, error) {
+	return d, nil
+}
@@ -0,0 +1,250 @@
+package discount
+
+import (
+	"sort"
+
+	"github.com/FoxComm/money"
+	"github.com/shopspring/decimal"
+)
+
+// PercentOff takes a percentage off the eligible lines, e.g. 15% off shoes
+type PercentOff struct {
+	Percent money.Percent
+
+	// SKUs limits the discount to these lines; empty means all lines
+	SKUs []string
+}
+
+// Priority implements Discount
+func (d PercentOff) Priority() int {
+	return PercentOffPriority
+}
+
+// Allocate implements Discount. Percentages over 100% take off no more than
+// the lines are worth.
+func (d PercentOff) Allocate(lines []Line, remaining []money.Money) ([]money.Money, error) {
+	base := worth(lines[0].UnitPrice.Currency(), lines, remaining, d.SKUs)
+	amount, err := base.ApplyPercent(d.Percent).Clamp(money.Zero(base.Currency()), base)
+	if err != nil {
+		return nil, err
+	}
+	return spread(amount, lines, remaining, d.SKUs)
+}
+
+// AmountOff takes a fixed amount off the eligible lines, e.g. USD 10.00 off,
+// but never more than they're worth
+type AmountOff struct {
+	Amount money.Money
+
+	// SKUs limits the discount to these lines; empty means all lines
+	SKUs []string
+}
+
+// Priority implements Discount
+func (d AmountOff) Priority() int {
+	return AmountOffPriority
+}
+
+// Allocate implements Discount
+func (d AmountOff) Allocate(lines []Line, remaining []money.Money) ([]money.Money, error) {
+	base := worth(lines[0].UnitPrice.Currency(), lines, remaining, d.SKUs)
+	amount, err := d.Amount.Clamp(money.Zero(base.Currency()), base)
+	if err != nil {
+		return nil, err
+	}
+	return spread(amount, lines, remaining, d.SKUs)
+}
+
+// FixedPrice sells every unit of the eligible lines at Price, e.g. all
+// t-shirts USD 5.00. Units already cheaper aren't discounted.
+type FixedPrice struct {
+	Price money.Money
+
+	// SKUs limits the discount to these lines; empty means all lines
+	SKUs []string
+}
+
+// Priority implements Discount
+func (d FixedPrice) Priority() int {
+	return FixedPricePriority
+}
+
+// Allocate implements Discount
+func (d FixedPrice) Allocate(lines []Line, remaining []money.Money) ([]money.Money, error) {
+	amounts := zeros(d.Price.Currency(), len(lines))
+	for i, line := range lines {
+		if !eligible(line, d.SKUs) {
+			continue
+		}
+
+		price := money.Make(d.Price.Amount().Mul(decimal.New(line.Quantity, 0)), d.Price.Currency())
+		off, err := remaining[i].Sub(price)
+		if err != nil {
+			return nil, err
+		}
+		if off.IsPositive() {
+			amounts[i] = off
+		}
+	}
+	return amounts, nil
+}
+
+// BuyXGetY discounts Get units for every Buy + Get eligible units, e.g. buy 2
+// get 1 free. The cheapest units are discounted by Percent, priced at what
+// their line is still worth per unit after discounts of lower priority.
+type BuyXGetY struct {
+	Buy     int64
+	Get     int64
+	Percent money.Percent
+
+	// SKUs limits the discount to these lines; empty means all lines
+	SKUs []string
+}
+
+// Priority implements Discount
+func (d BuyXGetY) Priority() int {
+	return BuyXGetYPriority
+}
+
+// Allocate implements Discount
+func (d BuyXGetY) Allocate(lines []Line, remaining []money.Money) ([]money.Money, error) {
+	amounts := zeros(lines[0].UnitPrice.Currency(), len(lines))
+	if d.Get < 1 || d.Buy < 0 {
+		return amounts, nil
+	}
+
+	var units int64
+	var order []int
+	for i, line := range lines {
+		if eligible(line, d.SKUs) && line.Quantity > 0 && remaining[i].IsPositive() {
+			units += line.Quantity
+			order = append(order, i)
+		}
+	}
+
+	// cheapest per unit first: remaining[a]/qty[a] < remaining[b]/qty[b]
+	sort.SliceStable(order, func(a, b int) bool {
+		x, y := lines[order[a]], lines[order[b]]
+		return remaining[order[a]].Amount().Mul(decimal.New(y.Quantity, 0)).
+			LessThan(remaining[order[b]].Amount().Mul(decimal.New(x.Quantity, 0)))
+	})
+
+	free := units / (d.Buy + d.Get) * d.Get
+	for _, i := range order {
+		if free == 0 {
+			break
+		}
+		n := lines[i].Quantity
+		if n > free {
+			n = free
+		}
+		free -= n
+
+		off, err := unitsOff(remaining[i], n, lines[i].Quantity, d.Percent).Clamp(amounts[i], remaining[i])
+		if err != nil {
+			return nil, err
+		}
+		amounts[i] = off
+	}
+	return amounts, nil
+}
+
+// unitsOff is percent of n of the quantity units a line is still worth, i.e.
+// worth × n / quantity × percent, rounded half up once
+func unitsOff(worth money.Money, n, quantity int64, p money.Percent) money.Money {
+	if n == quantity {
+		return worth.ApplyPercent(p)
+	}
+
+	c := worth.Currency()
+	amount := worth.Amount().Mul(decimal.New(n, 0)).Mul(p.Percent())
+	divisor := decimal.New(quantity*100, 0)
+	if !c.HasMinorUnits() {
+		return money.Make(amount.Div(divisor), c)
+	}
+	quo, _ := money.RoundHalfUp.Quo(amount, divisor, c.Digits())
+	return money.Make(quo, c)
+}
+
+// Tier is a discount which applies from a spend threshold on
+type Tier struct {
+	Threshold money.Money
+	Discount  Discount
+}
+
+// Tiered applies the discount of the highest tier whose threshold is met by
+// what the order is still worth, e.g. USD 10.00 off over USD 100.00 and
+// USD 25.00 off over USD 200.00.
+type Tiered struct {
+	Tiers []Tier
+}
+
+// Priority implements Discount
+func (d Tiered) Priority() int {
+	return TieredPriority
+}
+
+// Allocate implements Discount
+func (d Tiered) Allocate(lines []Line, remaining []money.Money) ([]money.Money, error) {
+	cur := lines[0].UnitPrice.Currency()
+	spend := worth(cur, lines, remaining, nil)
+
+	var best *Tier
+	for i, tier := range d.Tiers {
+		met, err := spend.GreaterThanOrEqual(tier.Threshold)
+		if err != nil {
+			return nil, err
+		}
+		if !met {
+			continue
+		}
+		if best == nil {
+			best = &d.Tiers[i]
+		} else if higher, _ := tier.Threshold.GreaterThan(best.Threshold); higher {
+			best = &d.Tiers[i]
+		}
+	}
+
+	if best == nil {
+		return zeros(cur, len(lines)), nil
+	}
+	return best.Discount.Allocate(lines, remaining)
+}
+
+// Capped limits a discount to Max, e.g. 20% off up to USD 50.00. A negative
+// Max allows no discount.
+type Capped struct {
+	Discount Discount
+	Max      money.Money
+}
+
+// Priority implements Discount
+func (d Capped) Priority() int {
+	return d.Discount.Priority()
+}
+
+// Allocate implements Discount
+func (d Capped) Allocate(lines []Line, remaining []money.Money) ([]money.Money, error) {
+	amounts, err := d.Discount.Allocate(lines, remaining)
+	if err != nil {
+		return nil, err
+	}
+
+	total := money.Zero(d.Max.Currency())
+	weights := make([]decimal.Decimal, len(amounts))
+	for i, amount := range amounts {
+		if total, err = total.Add(amount); err != nil {
+			return nil, err
+		}
+		weights[i] = amount.Amount()
+	}
+
+	max := d.Max
+	if max.IsNegative() {
+		max = money.Zero(max.Currency())
+	}
+	if over, _ := total.GreaterThan(max); !over {
+		return amounts, nil
+	}
+	return max.Allocate(weights...)
+}
@@ -0,0 +1,87 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/FoxComm/money/currency"
+)
+
+// Errors returned by operations which can't produce a correct result. All
+// errors work with errors.Is and errors.As; the Err* struct types carry
+// details of what went wrong.
+var (
+	// ErrDivisionByZero is returned when dividing by a zero amount
+	ErrDivisionByZero = errors.New("division by zero")
+
+	// ErrPrecisionLoss is returned when an amount can't be represented in its
+	// currency's minor unit without rounding
+	ErrPrecisionLoss = errors.New("amount exceeds currency precision")
+
+	// ErrOverflow is returned when an amount doesn't fit in an int64 of minor
+	// units. Convert to Money to continue with arbitrary precision.
+	ErrOverflow = errors.New("amount overflows int64 minor units")
+
+	// ErrInvalidBounds is returned when a lower bound is above its upper bound
+	ErrInvalidBounds = errors.New("lower bound is greater than upper bound")
+
+	// ErrDisjointRanges is returned when joining ranges which neither overlap
+	// nor touch
+	ErrDisjointRanges = errors.New("ranges are disjoint")
+
+	// ErrInvalidWeights is returned when allocating by a negative weight or
+	// only zero weights
+	ErrInvalidWeights = errors.New("weights must be non-negative and not all zero")
+
+	// ErrInvalidEncoding is returned when decoding truncated or malformed
+	// binary data, or data of an unsupported version
+	ErrInvalidEncoding = errors.New("invalid binary encoding")
+)
+
+// ErrDifferentCurrency is used for functions which take another money/currency
+// whereby the Money's currency != other currency
+type ErrDifferentCurrency struct {
+	Actual   currency.Currency
+	Expected currency.Currency
+}
+
+func (e *ErrDifferentCurrency) Error() string {
+	return fmt.Sprintf("expected currency %s got %s", e.Expected.Code, e.Actual.Code)
+}
+
+// ErrUnknownCurrency is returned when a currency code isn't in currency.Table
+type ErrUnknownCurrency struct {
+	Code string
+}
+
+func (e *ErrUnknownCurrency) Error() string {
+	return fmt.Sprintf("could not find currency %s", e.Code)
+}
+
+// ErrParse is returned when a string can't be parsed into an amount. Err is
+// the underlying cause, if any.
+type ErrParse struct {
+	Input string
+	Err   error
+}
+
+func (e *ErrParse) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("'%s' cannot be parsed: %s", e.Input, e.Err)
+	}
+	return fmt.Sprintf("'%s' cannot be parsed", e.Input)
+}
+
+// Unwrap returns the underlying cause
+func (e *ErrParse) Unwrap() error {
+	return e.Err
+}
+
+// ErrScan is returned when a database value has an unsupported type
+type ErrScan struct {
+	Value interface{}
+}
+
+func (e *ErrScan) Error() string {
+	return fmt.Sprintf("scan value of type %T is not supported", e.Value)
+}
@@ -0,0 +1,62 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/FoxComm/money/currency"
+)
+
+func TestErrorsAs(t *testing.T) {
+	_, err := Parse("ZZZ 10.00")
+	var unknown *ErrUnknownCurrency
+	if !errors.As(fmt.Errorf("wrapped: %w", err), &unknown) || unknown.Code != "ZZZ" {
+		t.Errorf("Parse() => %v, expected ErrUnknownCurrency ZZZ", err)
+	}
+
+	for _, str := range []string{"", "USD", "USD abc"} {
+		_, err = Parse(str)
+		var parseErr *ErrParse
+		if !errors.As(err, &parseErr) || parseErr.Input != str {
+			t.Errorf("Parse(%q) => %v, expected ErrParse", str, err)
+		}
+	}
+
+	_, err = MakeFromString("ten", USD)
+	var parseErr *ErrParse
+	if !errors.As(err, &parseErr) || parseErr.Err == nil {
+		t.Errorf("MakeFromString() => %v, expected ErrParse with a cause", err)
+	}
+
+	_, err = Make(d("1"), USD).Add(Make(d("1"), MXN))
+	var diffErr *ErrDifferentCurrency
+	if !errors.As(err, &diffErr) || diffErr.Actual == diffErr.Expected {
+		t.Errorf("Money.Add() => %v, expected ErrDifferentCurrency", err)
+	}
+
+	var m Money
+	err = m.Scan(10)
+	var scanErr *ErrScan
+	if !errors.As(err, &scanErr) || scanErr.Value != 10 {
+		t.Errorf("Money.Scan(10) => %v, expected ErrScan", err)
+	}
+}
+
+func TestErrorsIs(t *testing.T) {
+	if _, err := Make(d("1"), USD).Div(Zero(USD)); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Money.Div(0) => %v, expected ErrDivisionByZero", err)
+	}
+
+	if _, err := MakeExactMinor(100, USD).Div(d("0"), RoundHalfUp); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Exact.Div(0) => %v, expected ErrDivisionByZero", err)
+	}
+
+	if _, err := Make(d("0.001"), USD).ToExact(); !errors.Is(err, ErrPrecisionLoss) {
+		t.Errorf("Money.ToExact() => %v, expected ErrPrecisionLoss", err)
+	}
+
+	if _, err := Make(d("1e20"), USD).ToCompact(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Money.ToCompact() => %v, expected ErrOverflow", err)
+	}
+}
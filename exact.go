@@ -0,0 +1,179 @@
+package money
+
+import (
+	"database/sql/driver"
+
+	"github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+)
+
+// Exact represents an amount limited to its currency's minor unit, e.g. whole
+// cents for USD, as an immutable value. Use it for invoices and ledgers, and
+// Money for intermediate results like unit prices and FX. Currencies without
+// minor units, such as XAU, are not limited.
+type Exact struct {
+	money Money
+}
+
+// MakeExact is the Federal Reserve, to the cent. errors with ErrPrecisionLoss
+// if amount has more decimals than the currency allows.
+func MakeExact(amount decimal.Decimal, c currency.Currency) (Exact, error) {
+	return Make(amount, c).ToExact()
+}
+
+// MakeExactMinor makes Exact from an amount in its minor unit, e.g. cents
+func MakeExactMinor(minor int64, c currency.Currency) Exact {
+	return Exact{Make(decimal.New(minor, -c.Digits()), c)}
+}
+
+// ZeroExact returns Exact with a zero amount
+func ZeroExact(c currency.Currency) Exact {
+	return Exact{Zero(c)}
+}
+
+// ToExact converts to Exact. errors with ErrPrecisionLoss if the amount has
+// more decimals than the currency allows, see RoundToExact.
+func (m Money) ToExact() (Exact, error) {
+	if rounded := m.Round(); !rounded.amount.Equals(m.amount) {
+		return ZeroExact(m.currency), ErrPrecisionLoss
+	}
+	return Exact{m}, nil
+}
+
+// RoundToExact rounds to the currency's minor unit and converts to Exact
+func (m Money) RoundToExact(r Rounding) Exact {
+	return Exact{m.RoundWith(r)}
+}
+
+// Money converts to arbitrary precision Money
+func (e Exact) Money() Money {
+	return e.money
+}
+
+// Amount is the monetary value in its major unit
+func (e Exact) Amount() decimal.Decimal {
+	return e.money.amount
+}
+
+// Currency returns the set Currency
+func (e Exact) Currency() currency.Currency {
+	return e.money.currency
+}
+
+// String represents the amount in a currency context. e.g., for US: "USD 10.00"
+func (e Exact) String() string {
+	return e.money.String()
+}
+
+// Equals is true if other Exact is the same amount and currency
+func (e Exact) Equals(other Exact) bool {
+	return e.money.Equals(other.money)
+}
+
+// Cmp compares amounts. errors if currency is different.
+func (e Exact) Cmp(other Exact) (int, error) {
+	return e.money.Cmp(other.money)
+}
+
+// Negate negates the sign of the amount
+func (e Exact) Negate() Exact {
+	return Exact{e.money.Negate()}
+}
+
+// IsPositive returns true if the amount is > 0
+func (e Exact) IsPositive() bool {
+	return e.money.IsPositive()
+}
+
+// IsNegative returns true if the amount is < 0
+func (e Exact) IsNegative() bool {
+	return e.money.IsNegative()
+}
+
+// IsZero returns true if the amount is == 0
+func (e Exact) IsZero() bool {
+	return e.money.IsZero()
+}
+
+// Add adds amounts. errors if currency is different.
+func (e Exact) Add(other Exact) (Exact, error) {
+	m, err := e.money.Add(other.money)
+	return Exact{m}, err
+}
+
+// Sub subtracts amounts. errors if currency is different.
+func (e Exact) Sub(other Exact) (Exact, error) {
+	m, err := e.money.Sub(other.money)
+	return Exact{m}, err
+}
+
+// Mul multiplies the amount by factor, rounding the product with r
+func (e Exact) Mul(factor decimal.Decimal, r Rounding) Exact {
+	return Make(e.money.amount.Mul(factor), e.money.currency).RoundToExact(r)
+}
+
+// Div divides the amount by divisor, rounding the quotient with r. errors
+// with ErrDivisionByZero if divisor is zero.
+func (e Exact) Div(divisor decimal.Decimal, r Rounding) (Exact, error) {
+	c := e.money.currency
+	if divisor.Sign() == 0 {
+		return ZeroExact(c), ErrDivisionByZero
+	}
+	if !c.HasMinorUnits() {
+		return Exact{Make(e.money.amount.Div(divisor), c)}, nil
+	}
+
+	quo, err := r.Quo(e.money.amount, divisor, c.Digits())
+	return Exact{Make(quo, c)}, err
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (e *Exact) UnmarshalJSON(data []byte) (err error) {
+	if isNull(data) {
+		return nil
+	}
+
+	var m Money
+	if err = m.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*e, err = m.ToExact()
+	return
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e Exact) MarshalJSON() ([]byte, error) {
+	return e.money.MarshalJSON()
+}
+
+// Scan implements the sql.Scanner interface for database deserialization.
+func (e *Exact) Scan(value interface{}) (err error) {
+	var m Money
+	if err = m.Scan(value); err != nil {
+		return err
+	}
+	*e, err = m.ToExact()
+	return
+}
+
+// Value implements the driver.Valuer interface for database serialization.
+func (e Exact) Value() (driver.Value, error) {
+	return e.money.Value()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for XML
+// deserialization.
+func (e *Exact) UnmarshalText(text []byte) (err error) {
+	var m Money
+	if err = m.UnmarshalText(text); err != nil {
+		return err
+	}
+	*e, err = m.ToExact()
+	return
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for XML
+// serialization.
+func (e Exact) MarshalText() ([]byte, error) {
+	return e.money.MarshalText()
+}
@@ -0,0 +1,118 @@
+package money
+
+import (
+	"testing"
+
+	. "github.com/FoxComm/money/currency"
+)
+
+func TestToExact(t *testing.T) {
+	var monies = []struct {
+		money Money
+		err   error
+	}{
+		{Make(d("10"), USD), nil},
+		{Make(d("10.01"), USD), nil},
+		{Make(d("10.0100"), USD), nil},
+		{Make(d("10.005"), USD), ErrPrecisionLoss},
+		{Make(d("5000"), XAF), nil},
+		{Make(d("5000.5"), XAF), ErrPrecisionLoss},
+		{Make(d("1.23456"), XAU), nil},
+	}
+
+	for _, m := range monies {
+		exact, err := m.money.ToExact()
+		if err != m.err {
+			t.Errorf("Money.ToExact() => (%s, %v), expected error %v", exact, err, m.err)
+		} else if err == nil && !exact.Money().Equals(m.money) {
+			t.Errorf("Money.ToExact() => %s, expected %s", exact, m.money)
+		}
+	}
+
+	if _, err := MakeExact(d("0.001"), USD); err != ErrPrecisionLoss {
+		t.Errorf("MakeExact() => %v, expected ErrPrecisionLoss", err)
+	}
+}
+
+func TestRoundToExact(t *testing.T) {
+	exact := Make(d("10.005"), USD).RoundToExact(RoundHalfEven)
+	if expected := MakeExactMinor(1000, USD); !exact.Equals(expected) {
+		t.Errorf("Money.RoundToExact() => %s, expected %s", exact, expected)
+	}
+
+	exact = Make(d("10.005"), USD).RoundToExact(RoundHalfUp)
+	if expected := MakeExactMinor(1001, USD); !exact.Equals(expected) {
+		t.Errorf("Money.RoundToExact() => %s, expected %s", exact, expected)
+	}
+}
+
+func TestMakeExactMinor(t *testing.T) {
+	var monies = []struct {
+		minor    int64
+		currency Currency
+		expected string
+	}{
+		{1050, USD, "USD 10.50"},
+		{-1, USD, "USD -0.01"},
+		{5000, XAF, "XAF 5000"},
+		{3, XAU, "XAU 3"},
+	}
+
+	for _, m := range monies {
+		if actual := MakeExactMinor(m.minor, m.currency).String(); actual != m.expected {
+			t.Errorf("MakeExactMinor(%d, %s) => %s, expected %s", m.minor, m.currency, actual, m.expected)
+		}
+	}
+}
+
+func TestExactArithmetic(t *testing.T) {
+	price := MakeExactMinor(1000, USD)
+
+	sum, err := price.Add(MakeExactMinor(1, USD))
+	if err != nil || !sum.Equals(MakeExactMinor(1001, USD)) {
+		t.Errorf("Exact.Add() => (%s, %v), expected USD 10.01", sum, err)
+	}
+
+	diff, err := price.Sub(MakeExactMinor(1001, USD))
+	if err != nil || !diff.Equals(MakeExactMinor(-1, USD)) {
+		t.Errorf("Exact.Sub() => (%s, %v), expected USD -0.01", diff, err)
+	}
+
+	if _, err := price.Add(MakeExactMinor(1, MXN)); err == nil {
+		t.Errorf("Exact.Add() => expected ErrDifferentCurrency")
+	}
+
+	if product := price.Mul(d("0.0725"), RoundHalfUp); !product.Equals(MakeExactMinor(73, USD)) {
+		t.Errorf("Exact.Mul() => %s, expected USD 0.73", product)
+	}
+
+	if product := price.Mul(d("0.0725"), RoundDown); !product.Equals(MakeExactMinor(72, USD)) {
+		t.Errorf("Exact.Mul() => %s, expected USD 0.72", product)
+	}
+
+	if quotient, err := price.Div(d("3"), RoundHalfUp); err != nil || !quotient.Equals(MakeExactMinor(333, USD)) {
+		t.Errorf("Exact.Div() => (%s, %v), expected USD 3.33", quotient, err)
+	}
+
+	if _, err := price.Div(d("0"), RoundHalfUp); err != ErrDivisionByZero {
+		t.Errorf("Exact.Div() => %v, expected ErrDivisionByZero", err)
+	}
+}
+
+func TestExactJSON(t *testing.T) {
+	var exact Exact
+	if err := exact.UnmarshalJSON([]byte(`"USD 10.50"`)); err != nil {
+		t.Errorf("Exact.UnmarshalJSON() => unexpected error %s", err)
+	} else if !exact.Equals(MakeExactMinor(1050, USD)) {
+		t.Errorf("Exact.UnmarshalJSON() => %s, expected USD 10.50", exact)
+	}
+
+	if err := exact.UnmarshalJSON([]byte(`"USD 10.505"`)); err != ErrPrecisionLoss {
+		t.Errorf("Exact.UnmarshalJSON() => %v, expected ErrPrecisionLoss", err)
+	}
+
+	byt, err := MakeExactMinor(1050, USD).MarshalJSON()
+	if err != nil || string(byt) != `"USD 10.50"` {
+		t.Errorf("Exact.MarshalJSON() => (%s, %v), expected \"USD 10.50\"", byt, err)
+	}
+}
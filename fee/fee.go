@@ -0,0 +1,217 @@
+// Package fee calculates payment processor fees such as "2.9% + USD 0.30",
+// and grosses amounts up so what's left after fees is a given net.
+package fee
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/FoxComm/money"
+	"github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrNoFee is returned when a schedule has no fee for a currency
+	ErrNoFee = errors.New("no fee for currency")
+
+	// ErrInvalidFee is returned for fees which take 100% or more, have
+	// negative parts, or whose minimum is above their maximum
+	ErrInvalidFee = errors.New("invalid fee")
+
+	// ErrNegativeNet is returned when grossing up a negative net
+	ErrNegativeNet = errors.New("net is negative")
+)
+
+// Fee describes what a processor charges on a payment. The percentage and
+// fixed parts are added up, rounded half away from zero to the currency's
+// minor unit, then limited to Min and Max if set.
+type Fee struct {
+	Percent money.Percent `json:"percent"`
+	Fixed   *money.Money  `json:"fixed,omitempty"`
+	Min     *money.Money  `json:"min,omitempty"`
+	Max     *money.Money  `json:"max,omitempty"`
+
+	// CrossBorder is added to Percent for payments made from abroad
+	CrossBorder money.Percent `json:"cross_border"`
+}
+
+// Charge is the fee on a payment of amount. errors if the currencies are
+// different or with ErrInvalidFee.
+func (f Fee) Charge(amount money.Money, crossBorder bool) (money.Money, error) {
+	percent := f.Percent.Percent()
+	if crossBorder {
+		percent = percent.Add(f.CrossBorder.Percent())
+	}
+
+	calc := money.Chain(money.Make(amount.Amount().Mul(percent).Shift(-2), amount.Currency()))
+	if f.Fixed != nil {
+		calc = calc.Add(*f.Fixed)
+	}
+	charge, err := calc.Round().Result()
+	if err != nil {
+		return charge, err
+	}
+
+	if f.Min != nil && f.Max != nil {
+		clamped, err := charge.Clamp(*f.Min, *f.Max)
+		if err == money.ErrInvalidBounds {
+			err = ErrInvalidFee
+		}
+		return clamped, err
+	}
+	if f.Min != nil {
+		if below, err := charge.LessThan(*f.Min); err != nil || below {
+			return *f.Min, err
+		}
+	}
+	if f.Max != nil {
+		if above, err := charge.GreaterThan(*f.Max); err != nil || above {
+			return *f.Max, err
+		}
+	}
+	return charge, nil
+}
+
+// Net is what's left of a payment of amount after the fee
+func (f Fee) Net(amount money.Money, crossBorder bool) (money.Money, error) {
+	charge, err := f.Charge(amount, crossBorder)
+	if err != nil {
+		return money.Zero(amount.Currency()), err
+	}
+	return amount.Sub(charge)
+}
+
+// GrossUp is the smallest payment which leaves at least net after the fee,
+// e.g. USD 10.61 at 2.9% + USD 0.30 leaves USD 10.00. Due to rounding, what's
+// left may exceed net by a minor unit. errors if the currencies are different,
+// with ErrInvalidFee or with ErrNegativeNet.
+func (f Fee) GrossUp(net money.Money, crossBorder bool) (money.Money, error) {
+	if err := f.validate(); err != nil {
+		return money.Zero(net.Currency()), err
+	}
+	if net.IsNegative() {
+		return money.Zero(net.Currency()), ErrNegativeNet
+	}
+
+	c := net.Currency()
+	unit := decimal.New(1, -c.Digits())
+	leaves := func(gross decimal.Decimal) (bool, error) {
+		left, err := f.Net(money.Make(gross, c), crossBorder)
+		if err != nil {
+			return false, err
+		}
+		return left.GreaterThanOrEqual(net)
+	}
+
+	// what's left never shrinks as the payment grows, so double an upper
+	// bound, then search down to the minor unit
+	low := net.RoundWith(money.RoundCeiling).Amount()
+	step := unit
+	high := low.Add(step)
+	for {
+		ok, err := leaves(high)
+		if err != nil {
+			return money.Zero(c), err
+		}
+		if ok {
+			break
+		}
+		step = step.Mul(decimal.New(2, 0))
+		high = low.Add(step)
+	}
+
+	for high.Sub(low).Cmp(unit) > 0 {
+		mid := money.RoundFloor.Round(low.Add(high).Div(decimal.New(2, 0)), c.Digits())
+		ok, err := leaves(mid)
+		if err != nil {
+			return money.Zero(c), err
+		}
+		if ok {
+			high = mid
+		} else {
+			low = mid
+		}
+	}
+
+	if ok, _ := leaves(low); ok {
+		return money.Make(low, c), nil
+	}
+	return money.Make(high, c), nil
+}
+
+// validate errors with ErrInvalidFee if the fee has negative parts, takes
+// 100% or more from abroad, or its minimum is above its maximum
+func (f Fee) validate() error {
+	percent := f.Percent.Percent()
+	crossBorder := f.CrossBorder.Percent()
+	if percent.IsNegative() || crossBorder.IsNegative() || percent.Add(crossBorder).Cmp(decimal.New(100, 0)) >= 0 {
+		return ErrInvalidFee
+	}
+	for _, m := range []*money.Money{f.Fixed, f.Min, f.Max} {
+		if m != nil && m.IsNegative() {
+			return ErrInvalidFee
+		}
+	}
+	if f.Min != nil && f.Max != nil {
+		if above, _ := f.Min.GreaterThan(*f.Max); above {
+			return ErrInvalidFee
+		}
+	}
+	return nil
+}
+
+// Schedule holds the fee of every currency, keyed by ISO 4217 code, e.g.
+//
+//	{"USD": {"percent": "2.9%", "fixed": "USD 0.30", "cross_border": "1%"}}
+type Schedule map[string]Fee
+
+// ParseSchedule parses a JSON schedule. errors if a fee's amounts aren't in
+// the currency it's keyed by, or with ErrInvalidFee.
+func ParseSchedule(data []byte) (Schedule, error) {
+	var s Schedule
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+
+	for code, f := range s {
+		for _, m := range []*money.Money{f.Fixed, f.Min, f.Max} {
+			if m != nil && m.Currency().Code != code {
+				return nil, &money.ErrDifferentCurrency{Actual: m.Currency(), Expected: currency.Table[code]}
+			}
+		}
+		if err := f.validate(); err != nil {
+			return nil, fmt.Errorf("%s: %w", code, err)
+		}
+	}
+	return s, nil
+}
+
+// Fee returns the fee for currency c. errors with ErrNoFee.
+func (s Schedule) Fee(c currency.Currency) (Fee, error) {
+	f, ok := s[c.Code]
+	if !ok {
+		return Fee{}, ErrNoFee
+	}
+	return f, nil
+}
+
+// Charge is the fee on a payment of amount in its currency
+func (s Schedule) Charge(amount money.Money, crossBorder bool) (money.Money, error) {
+	f, err := s.Fee(amount.Currency())
+	if err != nil {
+		return money.Zero(amount.Currency()), err
+	}
+	return f.Charge(amount, crossBorder)
+}
+
+// GrossUp is the smallest payment in net's currency which leaves at least
+// net after its fee
+func (s Schedule) GrossUp(net money.Money, crossBorder bool) (money.Money, error) {
+	f, err := s.Fee(net.Currency())
+	if err != nil {
+		return money.Zero(net.Currency()), err
+	}
+	return f.GrossUp(net, crossBorder)
+}
@@ -0,0 +1,154 @@
+package fee
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/FoxComm/money"
+	. "github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+)
+
+func m(amount string) money.Money {
+	return money.MustMakeFromString(amount, USD)
+}
+
+func mp(amount string) *money.Money {
+	v := m(amount)
+	return &v
+}
+
+func percent(str string) money.Percent {
+	p, err := money.ParsePercent(str)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+var stripe = Fee{Percent: percent("2.9%"), Fixed: mp("0.30"), CrossBorder: percent("1%")}
+
+func TestCharge(t *testing.T) {
+	var charges = []struct {
+		fee         Fee
+		amount      string
+		crossBorder bool
+		expected    string
+	}{
+		{stripe, "10", false, "0.59"},
+		{stripe, "10", true, "0.69"},
+		{stripe, "100", false, "3.20"},
+		{stripe, "0.01", false, "0.30"},
+		{Fee{Percent: percent("1.5%"), Min: mp("0.50"), Max: mp("5")}, "10", false, "0.50"},
+		{Fee{Percent: percent("1.5%"), Min: mp("0.50"), Max: mp("5")}, "100", false, "1.50"},
+		{Fee{Percent: percent("1.5%"), Min: mp("0.50"), Max: mp("5")}, "1000", false, "5.00"},
+		{Fee{Percent: percent("1.5%"), Max: mp("5")}, "1000", false, "5.00"},
+		{Fee{Percent: percent("1.5%"), Min: mp("0.50")}, "1", false, "0.50"},
+	}
+
+	for _, c := range charges {
+		charge, err := c.fee.Charge(m(c.amount), c.crossBorder)
+		if err != nil || !charge.Equals(m(c.expected)) {
+			t.Errorf("Fee.Charge(%s, %t) => (%s, %v), expected %s", c.amount, c.crossBorder, charge, err, c.expected)
+		}
+	}
+
+	if _, err := stripe.Charge(money.MustMakeFromString("10", CAD), false); err == nil {
+		t.Errorf("Fee.Charge() => expected ErrDifferentCurrency")
+	}
+	if _, err := (Fee{Min: mp("2"), Max: mp("1")}).Charge(m("10"), false); err != ErrInvalidFee {
+		t.Errorf("Fee.Charge() => %v, expected ErrInvalidFee", err)
+	}
+}
+
+func TestGrossUp(t *testing.T) {
+	var nets = []struct {
+		fee         Fee
+		net         string
+		crossBorder bool
+		expected    string
+	}{
+		{stripe, "10", false, "10.61"},
+		{stripe, "10", true, "10.72"},
+		{stripe, "0", false, "0.31"},
+		{stripe, "1000", false, "1030.17"},
+		{Fee{Percent: percent("1.5%"), Min: mp("0.50"), Max: mp("5")}, "10", false, "10.50"},
+		{Fee{Percent: percent("1.5%"), Min: mp("0.50"), Max: mp("5")}, "1000", false, "1005.00"},
+		{Fee{}, "10", false, "10.00"},
+	}
+
+	for _, n := range nets {
+		gross, err := n.fee.GrossUp(m(n.net), n.crossBorder)
+		if err != nil || !gross.Equals(m(n.expected)) {
+			t.Errorf("Fee.GrossUp(%s, %t) => (%s, %v), expected %s", n.net, n.crossBorder, gross, err, n.expected)
+			continue
+		}
+
+		left, _ := n.fee.Net(gross, n.crossBorder)
+		less, _ := n.fee.Net(gross.MustSub(m("0.01")), n.crossBorder)
+		if ok, _ := left.GreaterThanOrEqual(m(n.net)); !ok {
+			t.Errorf("Fee.Net(%s) => %s, expected at least %s", gross, left, n.net)
+		}
+		if short, _ := less.LessThan(m(n.net)); !short {
+			t.Errorf("Fee.GrossUp(%s) => %s isn't the smallest gross", n.net, gross)
+		}
+	}
+
+	var invalid = []Fee{
+		{Percent: money.MakePercent(decimal.New(100, 0))},
+		{Percent: percent("-5%")},
+		{Percent: percent("2.9%"), CrossBorder: percent("-1%")},
+		{Fixed: mp("-0.30")},
+	}
+	for _, f := range invalid {
+		if _, err := f.GrossUp(m("10"), false); err != ErrInvalidFee {
+			t.Errorf("Fee.GrossUp(%+v) => %v, expected ErrInvalidFee", f, err)
+		}
+	}
+	if _, err := stripe.GrossUp(m("-10"), false); err != ErrNegativeNet {
+		t.Errorf("Fee.GrossUp(-10) => %v, expected ErrNegativeNet", err)
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	s, err := ParseSchedule([]byte(`{
+		"USD": {"percent": "2.9%", "fixed": "USD 0.30", "cross_border": "1%"},
+		"CAD": {"percent": "2.9%", "fixed": "CAD 0.30", "min": "CAD 0.50"}
+	}`))
+	if err != nil {
+		t.Fatalf("ParseSchedule() => unexpected error %s", err)
+	}
+
+	if charge, err := s.Charge(m("10"), true); err != nil || !charge.Equals(m("0.69")) {
+		t.Errorf("Schedule.Charge() => (%s, %v), expected USD 0.69", charge, err)
+	}
+	if gross, err := s.GrossUp(m("10"), false); err != nil || !gross.Equals(m("10.61")) {
+		t.Errorf("Schedule.GrossUp() => (%s, %v), expected USD 10.61", gross, err)
+	}
+	if charge, err := s.Charge(money.MustMakeFromString("1", CAD), false); err != nil || !charge.Equals(money.MustMakeFromString("0.50", CAD)) {
+		t.Errorf("Schedule.Charge() => (%s, %v), expected CAD 0.50", charge, err)
+	}
+	if _, err := s.Charge(money.MustMakeFromString("1", MXN), false); err != ErrNoFee {
+		t.Errorf("Schedule.Charge() => %v, expected ErrNoFee", err)
+	}
+
+	if _, err := ParseSchedule([]byte(`{"USD": {"percent": "2.9%", "fixed": "CAD 0.30"}}`)); err == nil {
+		t.Errorf("ParseSchedule() => expected ErrDifferentCurrency")
+	}
+	if _, err := ParseSchedule([]byte(`{"USD": {"percent": "2.9"}}`)); err == nil {
+		t.Errorf("ParseSchedule() => expected ErrParse for a percent without unit")
+	}
+
+	var invalid = []string{
+		`{"USD": {"percent": "150%"}}`,
+		`{"USD": {"percent": "95%", "cross_border": "5%"}}`,
+		`{"USD": {"percent": "-5%"}}`,
+		`{"USD": {"percent": "2.9%", "cross_border": "-1%"}}`,
+		`{"USD": {"percent": "2.9%", "fixed": "USD -0.30"}}`,
+	}
+	for _, input := range invalid {
+		if _, err := ParseSchedule([]byte(input)); !errors.Is(err, ErrInvalidFee) {
+			t.Errorf("ParseSchedule(%s) => %v, expected ErrInvalidFee", input, err)
+		}
+	}
+}
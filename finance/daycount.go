@@ -0,0 +1,89 @@
+package finance
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DayCount is a convention for how much of a year lies between two dates
+type DayCount int
+
+const (
+	// Thirty360 counts every month as 30 days of a 360 day year (US bond
+	// basis)
+	Thirty360 DayCount = iota
+
+	// Actual365 counts actual days of a 365 day year (ACT/365 Fixed)
+	Actual365
+
+	// ActualActual counts actual days of each calendar year, so days of a
+	// leap year weigh 1/366 (ACT/ACT ISDA)
+	ActualActual
+)
+
+// YearFraction is the part of a year between start and end, rounded to
+// decimal.DivisionPrecision digits
+func (dc DayCount) YearFraction(start, end time.Time) decimal.Decimal {
+	days, basis := dc.fraction(start, end)
+	return days.Div(basis)
+}
+
+// fraction is the exact part of a year between start and end as days / basis
+func (dc DayCount) fraction(start, end time.Time) (days, basis decimal.Decimal) {
+	switch dc {
+	case Thirty360:
+		y1, m1, d1 := start.Date()
+		y2, m2, d2 := end.Date()
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 && d1 == 30 {
+			d2 = 30
+		}
+		n := 360*(y2-y1) + 30*(int(m2)-int(m1)) + d2 - d1
+		return decimal.New(int64(n), 0), decimal.New(360, 0)
+
+	case ActualActual:
+		if end.Before(start) {
+			days, basis = dc.fraction(end, start)
+			return days.Neg(), basis
+		}
+
+		// sum days/365 and days/366 over a common basis
+		var normal, leap int64
+		for y := start.Year(); y <= end.Year(); y++ {
+			from, to := date(y, 1, 1), date(y+1, 1, 1)
+			if y == start.Year() {
+				from = start
+			}
+			if y == end.Year() {
+				to = end
+			}
+			if isLeap(y) {
+				leap += daysBetween(from, to)
+			} else {
+				normal += daysBetween(from, to)
+			}
+		}
+		return decimal.New(normal*366+leap*365, 0), decimal.New(365*366, 0)
+
+	default:
+		return decimal.New(daysBetween(start, end), 0), decimal.New(365, 0)
+	}
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// daysBetween counts calendar days, ignoring time of day and zone offsets
+func daysBetween(start, end time.Time) int64 {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	return int64(date(y2, m2, d2).Sub(date(y1, m1, d1)).Hours() / 24)
+}
+
+func isLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
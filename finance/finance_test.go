@@ -0,0 +1,203 @@
+package finance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/FoxComm/money"
+	. "github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+)
+
+func m(amount string) money.Money {
+	return money.MustMakeFromString(amount, USD)
+}
+
+func percent(str string) money.Percent {
+	p, err := money.ParsePercent(str)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func TestYearFraction(t *testing.T) {
+	var fractions = []struct {
+		dc         DayCount
+		start, end time.Time
+		expected   string
+	}{
+		{Thirty360, date(2024, 1, 1), date(2024, 7, 1), "0.5"},
+		{Thirty360, date(2024, 1, 31), date(2024, 3, 31), "0.1666666666666667"},
+		{Thirty360, date(2024, 2, 28), date(2024, 3, 31), "0.0916666666666667"},
+		{Actual365, date(2023, 1, 1), date(2024, 1, 1), "1"},
+		{Actual365, date(2024, 1, 1), date(2025, 1, 1), "1.0027397260273973"},
+		{ActualActual, date(2024, 1, 1), date(2025, 1, 1), "1"},
+		{ActualActual, date(2023, 7, 1), date(2024, 7, 1), "1.0013773486039374"},
+		{ActualActual, date(2024, 7, 1), date(2023, 7, 1), "-1.0013773486039374"},
+	}
+
+	for _, f := range fractions {
+		if actual := f.dc.YearFraction(f.start, f.end); !actual.Equals(decimal.RequireFromString(f.expected)) {
+			t.Errorf("%d.YearFraction(%s, %s) => %s, expected %s", f.dc, f.start.Format("2006-01-02"), f.end.Format("2006-01-02"), actual, f.expected)
+		}
+	}
+}
+
+func TestSimpleInterest(t *testing.T) {
+	var interests = []struct {
+		principal  string
+		rate       string
+		start, end time.Time
+		dc         DayCount
+		expected   string
+	}{
+		{"1000", "5%", date(2024, 1, 1), date(2024, 7, 1), Thirty360, "25.00"},
+		{"1000", "5%", date(2023, 1, 1), date(2023, 4, 1), Actual365, "12.33"},
+		{"1000", "5%", date(2024, 1, 1), date(2025, 1, 1), ActualActual, "50.00"},
+		{"0", "5%", date(2024, 1, 1), date(2025, 1, 1), ActualActual, "0.00"},
+	}
+
+	for _, i := range interests {
+		actual, err := SimpleInterest(m(i.principal), percent(i.rate), i.start, i.end, i.dc, money.RoundHalfUp)
+		if err != nil || !actual.Equals(m(i.expected)) {
+			t.Errorf("SimpleInterest(%s, %s) => (%s, %v), expected %s", i.principal, i.rate, actual, err, i.expected)
+		}
+	}
+
+	if _, err := SimpleInterest(m("1000"), percent("5%"), date(2025, 1, 1), date(2024, 1, 1), Thirty360, money.RoundHalfUp); err != ErrInvalidPeriod {
+		t.Errorf("SimpleInterest() reversed => %v, expected ErrInvalidPeriod", err)
+	}
+}
+
+func TestCompoundInterest(t *testing.T) {
+	var interests = []struct {
+		principal      string
+		rate           string
+		periodsPerYear int
+		start, end     time.Time
+		expected       string
+	}{
+		{"1000", "12%", 12, date(2024, 1, 1), date(2025, 1, 1), "126.83"},
+		{"1000", "12%", 12, date(2024, 1, 1), date(2024, 1, 16), "5.00"},
+		{"1000", "10%", 1, date(2024, 1, 1), date(2025, 7, 1), "155.00"},
+		{"1000", "10%", 1, date(2024, 1, 1), date(2024, 1, 1), "0.00"},
+	}
+
+	for _, i := range interests {
+		actual, err := CompoundInterest(m(i.principal), percent(i.rate), i.periodsPerYear, i.start, i.end, Thirty360, money.RoundHalfUp)
+		if err != nil || !actual.Equals(m(i.expected)) {
+			t.Errorf("CompoundInterest(%s, %s, %d) => (%s, %v), expected %s", i.principal, i.rate, i.periodsPerYear, actual, err, i.expected)
+		}
+	}
+
+	if _, err := CompoundInterest(m("1000"), percent("5%"), 0, date(2024, 1, 1), date(2025, 1, 1), Thirty360, money.RoundHalfUp); err != ErrInvalidLoan {
+		t.Errorf("CompoundInterest() => %v, expected ErrInvalidLoan", err)
+	}
+	if _, err := CompoundInterest(m("1000"), percent("12%"), 12, date(2025, 1, 1), date(2024, 1, 1), Thirty360, money.RoundHalfUp); err != ErrInvalidPeriod {
+		t.Errorf("CompoundInterest() reversed => %v, expected ErrInvalidPeriod", err)
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	var schedules = []struct {
+		loan      Loan
+		principal []string
+		interest  []string
+	}{
+		{
+			Loan{Principal: m("1000"), Rate: percent("12%"), Installments: 3, PeriodsPerYear: 12, Method: EqualPrincipal},
+			[]string{"333.33", "333.33", "333.34"},
+			[]string{"10.00", "6.67", "3.33"},
+		},
+		{
+			Loan{Principal: m("100"), Installments: 3, PeriodsPerYear: 12},
+			[]string{"33.33", "33.33", "33.34"},
+			[]string{"0.00", "0.00", "0.00"},
+		},
+		{
+			Loan{Principal: m("1000"), Rate: percent("12%"), Installments: 2, PeriodsPerYear: 12, Method: EqualPrincipal,
+				Start: date(2024, 1, 15), DayCount: Actual365},
+			[]string{"500.00", "500.00"},
+			[]string{"10.19", "4.77"},
+		},
+	}
+
+	for _, s := range schedules {
+		installments, err := s.loan.Schedule()
+		if err != nil || len(installments) != len(s.principal) {
+			t.Errorf("Loan.Schedule() => (%v, %v), expected %d installments", installments, err, len(s.principal))
+			continue
+		}
+		for k, i := range installments {
+			if !i.Principal.Equals(m(s.principal[k])) || !i.Interest.Equals(m(s.interest[k])) {
+				t.Errorf("Loan.Schedule()[%d] => (%s, %s), expected (%s, %s)", k, i.Principal, i.Interest, s.principal[k], s.interest[k])
+			}
+		}
+	}
+}
+
+func TestScheduleEqualPayment(t *testing.T) {
+	loan := Loan{Principal: m("1000"), Rate: percent("12%"), Installments: 12, PeriodsPerYear: 12,
+		Start: date(2024, 1, 31), DayCount: Thirty360}
+	installments, err := loan.Schedule()
+	if err != nil {
+		t.Fatalf("Loan.Schedule() => %v", err)
+	}
+
+	principal := m("0")
+	for k, i := range installments {
+		if k < len(installments)-1 && !i.Payment.Equals(m("88.85")) {
+			t.Errorf("Loan.Schedule()[%d].Payment => %s, expected USD 88.85", k, i.Payment)
+		}
+		if !i.Payment.Equals(i.Principal.MustAdd(i.Interest)) {
+			t.Errorf("Loan.Schedule()[%d] => %s != %s + %s", k, i.Payment, i.Principal, i.Interest)
+		}
+		principal = principal.MustAdd(i.Principal)
+	}
+
+	last := installments[len(installments)-1]
+	if !principal.Equals(m("1000")) || !last.Balance.IsZero() {
+		t.Errorf("Loan.Schedule() => principal %s, balance %s, expected USD 1000.00 and zero", principal, last.Balance)
+	}
+	if !last.Due.Equal(date(2025, 1, 31)) {
+		t.Errorf("Loan.Schedule() => last due %s, expected 2025-01-31", last.Due)
+	}
+}
+
+func TestScheduleErrors(t *testing.T) {
+	var loans = []struct {
+		loan     Loan
+		expected error
+	}{
+		{Loan{Principal: m("1000"), PeriodsPerYear: 12}, ErrInvalidLoan},
+		{Loan{Principal: m("1000"), Installments: 12}, ErrInvalidLoan},
+		{Loan{Principal: m("1000"), Installments: 12, PeriodsPerYear: 5, Start: date(2024, 1, 1)}, ErrInvalidLoan},
+		{Loan{Principal: m("1000.005"), Installments: 12, PeriodsPerYear: 12}, money.ErrPrecisionLoss},
+	}
+
+	for _, l := range loans {
+		if _, err := l.loan.Schedule(); err != l.expected {
+			t.Errorf("Loan.Schedule() => %v, expected %v", err, l.expected)
+		}
+	}
+}
+
+func TestAddMonths(t *testing.T) {
+	var dates = []struct {
+		t        time.Time
+		months   int
+		expected time.Time
+	}{
+		{date(2024, 1, 31), 1, date(2024, 2, 29)},
+		{date(2023, 1, 31), 1, date(2023, 2, 28)},
+		{date(2024, 1, 31), 3, date(2024, 4, 30)},
+		{date(2024, 11, 15), 3, date(2025, 2, 15)},
+	}
+
+	for _, d := range dates {
+		if actual := addMonths(d.t, d.months); !actual.Equal(d.expected) {
+			t.Errorf("addMonths(%s, %d) => %s, expected %s", d.t, d.months, actual, d.expected)
+		}
+	}
+}
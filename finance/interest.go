@@ -0,0 +1,81 @@
+// Package finance calculates interest and installment schedules on
+// money.Money, with configurable day count conventions.
+package finance
+
+import (
+	"errors"
+	"time"
+
+	"github.com/FoxComm/money"
+	"github.com/shopspring/decimal"
+)
+
+// precision is the number of decimals kept for compounding factors
+const precision = 34
+
+// ErrInvalidPeriod is returned for interest periods which end before they
+// start
+var ErrInvalidPeriod = errors.New("invalid period")
+
+// SimpleInterest is the interest on principal at an annual rate between start
+// and end, rounded to the currency's minor unit with r. errors with
+// ErrInvalidPeriod.
+func SimpleInterest(principal money.Money, rate money.Percent, start, end time.Time, dc DayCount, r money.Rounding) (money.Money, error) {
+	if end.Before(start) {
+		return money.Zero(principal.Currency()), ErrInvalidPeriod
+	}
+
+	days, basis := dc.fraction(start, end)
+	interest := principal.Amount().Mul(rate.Percent()).Mul(days)
+	return quo(principal, interest, basis.Shift(2), r), nil
+}
+
+// CompoundInterest is the interest on principal at an annual rate compounded
+// periodsPerYear times between start and end, rounded to the currency's
+// minor unit with r. A final partial period accrues simple interest. errors
+// with ErrInvalidLoan or ErrInvalidPeriod.
+func CompoundInterest(principal money.Money, rate money.Percent, periodsPerYear int, start, end time.Time, dc DayCount, r money.Rounding) (money.Money, error) {
+	if periodsPerYear < 1 {
+		return money.Zero(principal.Currency()), ErrInvalidLoan
+	}
+	if end.Before(start) {
+		return money.Zero(principal.Currency()), ErrInvalidPeriod
+	}
+
+	days, basis := dc.fraction(start, end)
+	n := decimal.New(int64(periodsPerYear), 0)
+	periods := days.Mul(n)
+	whole := periods.Div(basis).Truncate(0)
+	stub := periods.Sub(whole.Mul(basis))
+
+	i := rate.Rate().Div(n)
+	factor := pow(decimal.New(1, 0).Add(i), whole.IntPart())
+	factor = factor.Mul(basis.Add(i.Mul(stub))).Round(precision)
+
+	grown := principal.Amount().Mul(factor)
+	interest := quo(principal, grown, basis, r)
+	return interest.Sub(principal)
+}
+
+// pow raises base to a non-negative integer power by squaring, keeping
+// precision decimals
+func pow(base decimal.Decimal, exp int64) decimal.Decimal {
+	result := decimal.New(1, 0)
+	for ; exp > 0; exp >>= 1 {
+		if exp&1 == 1 {
+			result = result.Mul(base).Round(precision)
+		}
+		base = base.Mul(base).Round(precision)
+	}
+	return result
+}
+
+// quo is a / b in principal's currency, rounded to its minor unit with r
+func quo(principal money.Money, a, b decimal.Decimal, r money.Rounding) money.Money {
+	c := principal.Currency()
+	if !c.HasMinorUnits() {
+		return money.Make(a.Div(b), c)
+	}
+	amount, _ := r.Quo(a, b, c.Digits())
+	return money.Make(amount, c)
+}
@@ -0,0 +1,137 @@
+package finance
+
+import (
+	"errors"
+	"time"
+
+	"github.com/FoxComm/money"
+	"github.com/shopspring/decimal"
+)
+
+// ErrInvalidLoan is returned for loans without installments or periods, or
+// with dated periods which don't divide a year into whole months
+var ErrInvalidLoan = errors.New("invalid loan terms")
+
+// Method decides how a loan is paid back
+type Method int
+
+const (
+	// EqualPayment pays the same amount every period (annuity); early
+	// payments are mostly interest
+	EqualPayment Method = iota
+
+	// EqualPrincipal pays back the same principal every period, so payments
+	// shrink with the interest
+	EqualPrincipal
+)
+
+// Loan describes installment financing
+type Loan struct {
+	Principal money.Money
+
+	// Rate is the nominal annual interest rate
+	Rate money.Percent
+
+	Installments   int
+	PeriodsPerYear int
+	Method         Method
+	Rounding       money.Rounding
+
+	// Start dates the installments, one period apart; days past the end of a
+	// shorter month fall on its last day. Interest then accrues per DayCount
+	// between due dates; otherwise every period accrues Rate / PeriodsPerYear.
+	Start    time.Time
+	DayCount DayCount
+}
+
+// Installment is one payment of a loan
+type Installment struct {
+	Number int
+	Due    time.Time
+
+	// Payment is Principal + Interest
+	Payment   money.Money
+	Principal money.Money
+	Interest  money.Money
+
+	// Balance is the principal left after this installment
+	Balance money.Money
+}
+
+// Schedule is the installments of the loan. Every amount is rounded to the
+// currency's minor unit, and the final installment pays off what's left, so
+// principals add up to the loan's principal to the cent. errors with
+// ErrInvalidLoan, or money.ErrPrecisionLoss if the principal isn't in minor
+// units.
+func (l Loan) Schedule() ([]Installment, error) {
+	if l.Installments < 1 || l.PeriodsPerYear < 1 {
+		return nil, ErrInvalidLoan
+	}
+	if !l.Start.IsZero() && 12%l.PeriodsPerYear != 0 {
+		return nil, ErrInvalidLoan
+	}
+	if _, err := l.Principal.ToExact(); err != nil {
+		return nil, err
+	}
+
+	c := l.Principal.Currency()
+	n := decimal.New(int64(l.Installments), 0)
+	i := l.Rate.Rate().Div(decimal.New(int64(l.PeriodsPerYear), 0))
+
+	var level money.Money
+	if l.Method == EqualPrincipal || i.Sign() == 0 {
+		level = quo(l.Principal, l.Principal.Amount(), n, l.Rounding)
+	} else {
+		v := pow(decimal.New(1, 0).Add(i), int64(l.Installments))
+		level = quo(l.Principal, l.Principal.Amount().Mul(i).Mul(v), v.Sub(decimal.New(1, 0)), l.Rounding)
+	}
+
+	balance := l.Principal
+	due := l.Start
+	installments := make([]Installment, l.Installments)
+	for k := range installments {
+		var interest money.Money
+		if l.Start.IsZero() {
+			interest = quo(balance, balance.Amount().Mul(i), decimal.New(1, 0), l.Rounding)
+		} else {
+			next := addMonths(l.Start, (k+1)*12/l.PeriodsPerYear)
+			var err error
+			if interest, err = SimpleInterest(balance, l.Rate, due, next, l.DayCount, l.Rounding); err != nil {
+				return nil, err
+			}
+			due = next
+		}
+
+		principal := level
+		if l.Method == EqualPayment && i.Sign() != 0 {
+			principal = level.MustSub(interest)
+		}
+		if k == len(installments)-1 {
+			principal = balance
+		} else if over, _ := principal.GreaterThan(balance); over {
+			principal = balance
+		}
+
+		balance = balance.MustSub(principal)
+		installments[k] = Installment{
+			Number:    k + 1,
+			Due:       due,
+			Payment:   principal.MustAdd(interest),
+			Principal: principal,
+			Interest:  interest,
+			Balance:   money.Make(balance.Amount(), c),
+		}
+	}
+	return installments, nil
+}
+
+// addMonths adds months to t, clamping to the end of shorter months, e.g.
+// Jan 31 + 1 month => Feb 29 in a leap year
+func addMonths(t time.Time, months int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); d > last {
+		d = last
+	}
+	return time.Date(first.Year(), first.Month(), d, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
@@ -0,0 +1,21 @@
+module github.com/FoxComm/money
+
+go 1.25.0
+
+require (
+	github.com/fxamacker/cbor/v2 v2.9.2
+	github.com/jackc/pgx/v5 v5.9.2
+	github.com/shopspring/decimal v1.2.0
+	github.com/vmihailenco/msgpack/v5 v5.4.1
+	go.mongodb.org/mongo-driver v1.17.6
+	google.golang.org/genproto v0.0.0-20250603155806-513f23925822
+	google.golang.org/protobuf v1.36.9
+)
+
+require (
+	github.com/jackc/pgpassfile v1.0.0 // indirect
+	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
+	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
+	github.com/x448/float16 v0.8.4 // indirect
+	golang.org/x/text v0.29.0 // indirect
+)
@@ -23,10 +23,29 @@ type Currency struct {
 	Decimal   string `json:"decimal_mark"`
 	Delimiter string `json:"thousands_separator"`
 	Minor     int    `json:"subunit_to_unit"`
+	Kind      string `json:"kind"`
+}
+
+// kinds maps the json "kind" to its currency.Kind constant
+var kinds = map[string]string{
+	"":               "Fiat",
+	"fiat":           "Fiat",
+	"precious_metal": "PreciousMetal",
+	"fund":           "Fund",
+	"supranational":  "Supranational",
+	"testing":        "Testing",
+	"no_currency":    "NoCurrency",
+	"custom":         "Custom",
 }
 
 var funcMap = template.FuncMap{
 	"ToUpper": strings.ToUpper,
+	"ToKind": func(kind string) string {
+		if k, ok := kinds[kind]; ok {
+			return k
+		}
+		panic("unknown currency kind " + kind)
+	},
 }
 
 var currencyTmpl = template.Must(template.New("currency-file").Funcs(funcMap).Parse(`
@@ -38,6 +57,7 @@ var {{ .Code }} = Currency{
 	Decimal: '{{ .Decimal }}',
 	Delimiter: '{{ .Delimiter }}',
 	Minor: {{ .Minor }},
+	Kind: {{ .Kind | ToKind }},
 }
 
 `))
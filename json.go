@@ -0,0 +1,124 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+)
+
+// JSONObject encodes Money as an object with a decimal string amount, e.g.
+// {"amount":"10.00","currency":"USD"}. Decoding accepts every form Money does.
+type JSONObject struct {
+	Money
+}
+
+// JSONMinor encodes Money as an object with an integer amount in the minor
+// unit, e.g. {"amount_minor":1000,"currency":"USD"}. Decoding accepts every
+// form Money does.
+type JSONMinor struct {
+	Money
+}
+
+// jsonMoney is the union of the object forms for decoding. Amounts may be
+// JSON strings or numbers.
+type jsonMoney struct {
+	Amount      *json.Number `json:"amount"`
+	AmountMinor *json.Number `json:"amount_minor"`
+	Currency    string       `json:"currency"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (j JSONObject) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+	}{j.amountString(), j.currency.Code})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (j *JSONObject) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(&j.Money, data)
+}
+
+// MarshalJSON implements the json.Marshaler interface. errors with
+// ErrPrecisionLoss if the amount isn't a whole number of minor units.
+func (j JSONMinor) MarshalJSON() ([]byte, error) {
+	minor := j.AmountMinor()
+	if !minor.Equals(minor.Truncate(0)) {
+		return nil, ErrPrecisionLoss
+	}
+	return json.Marshal(struct {
+		AmountMinor json.Number `json:"amount_minor"`
+		Currency    string      `json:"currency"`
+	}{json.Number(minor.String()), j.currency.Code})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (j *JSONMinor) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(&j.Money, data)
+}
+
+// unmarshalJSON decodes any JSON form of Money into m. null is a no-op, as
+// for the standard library's types.
+func unmarshalJSON(m *Money, data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case isNull(data):
+		return nil
+
+	case len(data) > 0 && data[0] == '"':
+		str, err := strconv.Unquote(string(data))
+		if err != nil {
+			return &ErrParse{string(data), err}
+		}
+		parsed, err := Parse(str)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+		return nil
+	}
+
+	var obj jsonMoney
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&obj); err != nil {
+		return &ErrParse{string(data), err}
+	}
+	if (obj.Amount == nil) == (obj.AmountMinor == nil) {
+		return &ErrParse{Input: string(data)}
+	}
+
+	c, ok := currency.Table[obj.Currency]
+	if !ok {
+		return &ErrUnknownCurrency{obj.Currency}
+	}
+
+	if obj.Amount != nil {
+		amount, err := decimal.NewFromString(obj.Amount.String())
+		if err != nil {
+			return &ErrParse{string(data), err}
+		}
+		*m = Make(amount, c)
+		return nil
+	}
+
+	minor, err := decimal.NewFromString(obj.AmountMinor.String())
+	if err != nil {
+		return &ErrParse{string(data), err}
+	}
+	if !minor.Equals(minor.Truncate(0)) {
+		return &ErrParse{Input: string(data)}
+	}
+	*m = Make(minor.Shift(-c.Digits()), c)
+	return nil
+}
+
+// isNull is true if data is the JSON null, which UnmarshalJSON methods treat
+// as a no-op
+func isNull(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
@@ -0,0 +1,156 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/FoxComm/money/currency"
+)
+
+func TestUnmarshalJSONForms(t *testing.T) {
+	var forms = []struct {
+		json     string
+		expected Money
+	}{
+		{`"USD 10.00"`, Make(d("10"), USD)},
+		{`{"amount":"10.00","currency":"USD"}`, Make(d("10"), USD)},
+		{`{"currency":"USD","amount":10.5}`, Make(d("10.5"), USD)},
+		{`{"amount":"0.333","currency":"USD"}`, Make(d("0.333"), USD)},
+		{`{"amount_minor":1000,"currency":"USD"}`, Make(d("10"), USD)},
+		{`{"amount_minor":-5,"currency":"USD"}`, Make(d("-0.05"), USD)},
+		{`{"amount_minor":5000,"currency":"XAF"}`, Make(d("5000"), XAF)},
+		{`{"amount_minor":92233720368547758070,"currency":"USD"}`, Make(d("922337203685477580.70"), USD)},
+		{` {"amount_minor": 1000, "currency": "USD"} `, Make(d("10"), USD)},
+	}
+
+	for _, f := range forms {
+		var m Money
+		if err := json.Unmarshal([]byte(f.json), &m); err != nil || !m.Equals(f.expected) {
+			t.Errorf("Money.UnmarshalJSON(%s) => (%s, %v), expected %s", f.json, m, err, f.expected)
+		}
+	}
+}
+
+func TestUnmarshalJSONNull(t *testing.T) {
+	m := Make(d("10"), USD)
+	if err := json.Unmarshal([]byte(`null`), &m); err != nil || !m.Equals(Make(d("10"), USD)) {
+		t.Errorf("Money.UnmarshalJSON(null) => (%s, %v), expected untouched USD 10.00", m, err)
+	}
+
+	e := MakeExactMinor(100, USD)
+	if err := json.Unmarshal([]byte(`null`), &e); err != nil || !e.Equals(MakeExactMinor(100, USD)) {
+		t.Errorf("Exact.UnmarshalJSON(null) => (%s, %v), expected untouched USD 1.00", e, err)
+	}
+
+	c := MakeCompact(100, USD)
+	if err := json.Unmarshal([]byte(` null `), &c); err != nil || c != MakeCompact(100, USD) {
+		t.Errorf("Compact.UnmarshalJSON(null) => (%s, %v), expected untouched USD 1.00", c, err)
+	}
+
+	var obj struct {
+		Price *Money `json:"price"`
+	}
+	if err := json.Unmarshal([]byte(`{"price":null}`), &obj); err != nil || obj.Price != nil {
+		t.Errorf("json.Unmarshal() => (%v, %v), expected nil price", obj.Price, err)
+	}
+
+	var doc struct {
+		Rate   Percent `json:"rate"`
+		Prices Range   `json:"prices"`
+	}
+	doc.Rate = MakePercent(d("15"))
+	if err := json.Unmarshal([]byte(`{"rate":null,"prices":null}`), &doc); err != nil || doc.Rate.String() != "15%" || doc.Prices != (Range{}) {
+		t.Errorf("json.Unmarshal() => (%s, %s, %v), expected untouched 15%% and empty range", doc.Rate, doc.Prices, err)
+	}
+	if err := json.Unmarshal([]byte(`{"rate":"null"}`), &doc); err == nil {
+		t.Errorf("Percent.UnmarshalJSON(\"null\") => expected ErrParse")
+	}
+}
+
+func TestUnmarshalJSONErrors(t *testing.T) {
+	var parseErrors = []string{
+		`{"currency":"USD"}`,
+		`{"amount":"10","amount_minor":1000,"currency":"USD"}`,
+		`{"amount":"ten","currency":"USD"}`,
+		`{"amount_minor":10.5,"currency":"USD"}`,
+		`{"amount":"10",`,
+		`[]`,
+	}
+
+	for _, input := range parseErrors {
+		var m Money
+		var e *ErrParse
+		if err := m.UnmarshalJSON([]byte(input)); !errors.As(err, &e) {
+			t.Errorf("Money.UnmarshalJSON(%s) => %v, expected ErrParse", input, err)
+		}
+	}
+
+	var m Money
+	var e *ErrUnknownCurrency
+	if err := m.UnmarshalJSON([]byte(`{"amount":"10","currency":"ZZZ"}`)); !errors.As(err, &e) || e.Code != "ZZZ" {
+		t.Errorf("Money.UnmarshalJSON() => %v, expected ErrUnknownCurrency", err)
+	}
+}
+
+func TestJSONObject(t *testing.T) {
+	var monies = []struct {
+		money    Money
+		expected string
+	}{
+		{Make(d("10"), USD), `{"amount":"10.00","currency":"USD"}`},
+		{Make(d("-0.333"), USD), `{"amount":"-0.333","currency":"USD"}`},
+		{Make(d("1.5"), XAU), `{"amount":"1.5","currency":"XAU"}`},
+	}
+
+	for _, m := range monies {
+		byt, err := json.Marshal(JSONObject{m.money})
+		if err != nil || string(byt) != m.expected {
+			t.Errorf("JSONObject.MarshalJSON() => (%s, %v), expected %s", byt, err, m.expected)
+		}
+
+		var parsed JSONObject
+		if err := json.Unmarshal(byt, &parsed); err != nil || !parsed.Equals(m.money) {
+			t.Errorf("JSONObject.UnmarshalJSON(%s) => (%s, %v), expected %s", byt, parsed.Money, err, m.money)
+		}
+	}
+}
+
+func TestJSONMinor(t *testing.T) {
+	var monies = []struct {
+		money    Money
+		expected string
+	}{
+		{Make(d("10"), USD), `{"amount_minor":1000,"currency":"USD"}`},
+		{Make(d("-0.05"), USD), `{"amount_minor":-5,"currency":"USD"}`},
+		{Make(d("5000"), XAF), `{"amount_minor":5000,"currency":"XAF"}`},
+	}
+
+	for _, m := range monies {
+		byt, err := json.Marshal(JSONMinor{m.money})
+		if err != nil || string(byt) != m.expected {
+			t.Errorf("JSONMinor.MarshalJSON() => (%s, %v), expected %s", byt, err, m.expected)
+		}
+
+		var parsed JSONMinor
+		if err := json.Unmarshal(byt, &parsed); err != nil || !parsed.Equals(m.money) {
+			t.Errorf("JSONMinor.UnmarshalJSON(%s) => (%s, %v), expected %s", byt, parsed.Money, err, m.money)
+		}
+	}
+
+	if _, err := (JSONMinor{Make(d("10.005"), USD)}).MarshalJSON(); err != ErrPrecisionLoss {
+		t.Errorf("JSONMinor.MarshalJSON() => %v, expected ErrPrecisionLoss", err)
+	}
+
+	var parsed JSONMinor
+	if err := json.Unmarshal([]byte(`"USD 10.00"`), &parsed); err != nil || !parsed.Equals(Make(d("10"), USD)) {
+		t.Errorf("JSONMinor.UnmarshalJSON() => (%s, %v), expected USD 10.00", parsed.Money, err)
+	}
+}
+
+func TestExactUnmarshalJSONObject(t *testing.T) {
+	var e Exact
+	if err := json.Unmarshal([]byte(`{"amount_minor":1050,"currency":"USD"}`), &e); err != nil || !e.Equals(MakeExactMinor(1050, USD)) {
+		t.Errorf("Exact.UnmarshalJSON() => (%s, %v), expected USD 10.50", e, err)
+	}
+}
@@ -2,7 +2,6 @@ package money
 
 import (
 	"database/sql/driver"
-	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -19,17 +18,6 @@ type Money struct {
 	currency currency.Currency
 }
 
-// ErrDifferentCurrency is used for functions which take another money/currency
-// whereby the Money's currency != other currency
-type ErrDifferentCurrency struct {
-	Actual   currency.Currency
-	Expected currency.Currency
-}
-
-func (e *ErrDifferentCurrency) Error() string {
-	return fmt.Sprintf("expected currency %s got %s", e.Expected.Code, e.Actual.Code)
-}
-
 // Make is the Federal Reserve
 func Make(amount decimal.Decimal, c currency.Currency) Money {
 	return Money{amount, c}
@@ -39,7 +27,7 @@ func Make(amount decimal.Decimal, c currency.Currency) Money {
 func MakeFromString(amount string, c currency.Currency) (Money, error) {
 	d, err := decimal.NewFromString(amount)
 	if err != nil {
-		return Zero(c), err
+		return Zero(c), &ErrParse{amount, err}
 	}
 
 	return Money{d, c}, nil
@@ -56,25 +44,25 @@ func Parse(str string) (money Money, err error) {
 	var ok bool
 
 	if len(str) < 4 {
-		err = fmt.Errorf("'%s' cannot be parsed", str)
+		err = &ErrParse{Input: str}
 		return
 	}
 
 	parsed := parseRegex.FindStringSubmatch(str[4:])
 	if len(parsed) == 0 {
-		err = fmt.Errorf("'%s' cannot be parsed", str)
+		err = &ErrParse{Input: str}
 		return
 	}
 
 	if c, ok = currency.Table[str[0:3]]; !ok {
-		err = fmt.Errorf("could not find currency %s", str[0:3])
+		err = &ErrUnknownCurrency{str[0:3]}
 		return
 	}
 
 	amountStr := strings.Replace(parsed[0], string(c.Delimiter), "", 0)
 
 	if amount, err := decimal.NewFromString(amountStr); err != nil {
-		return money, err
+		return money, &ErrParse{str, err}
 	} else {
 		return Make(amount, c), nil
 	}
@@ -85,18 +73,24 @@ func (m Money) Amount() decimal.Decimal {
 	return m.amount
 }
 
-// Amount is the monetary value in its minor unit
+// AmountMinor is the monetary value in its minor unit, e.g. cents for USD
 func (m Money) AmountMinor() decimal.Decimal {
-	return decimal.Decimal{}
+	return m.amount.Shift(m.currency.Digits())
 }
 
 // String represents the amount in a currency context. e.g., for US: "USD 10.00"
+// Amounts are padded to the currency's minor unit but never truncated, and
+// currencies without minor units are not padded, e.g. "XAU 1.5".
 func (m Money) String() string {
-	amt := m.Amount().String()
-	if !strings.Contains(amt, string(m.currency.Decimal)) {
-		amt += fmt.Sprintf("%s00", string(m.currency.Decimal))
+	return fmt.Sprintf("%s %s", m.currency.Code, m.amountString())
+}
+
+// amountString is the amount as formatted by String, without the currency
+func (m Money) amountString() string {
+	if digits := m.currency.Digits(); m.amount.Equals(m.amount.Round(digits)) {
+		return m.amount.StringFixed(digits)
 	}
-	return fmt.Sprintf("%s %s", m.currency.Code, amt)
+	return m.amount.String()
 }
 
 // Equals is true if other Money is the same amount and currency
@@ -109,17 +103,18 @@ func (m Money) Currency() currency.Currency {
 	return m.currency
 }
 
+// Round rounds the amount half away from zero to the currency's minor unit,
+// e.g. cents for USD. Currencies without minor units, such as XAU, are left
+// untouched.
+func (m Money) Round() Money {
+	return m.RoundWith(RoundHalfUp)
+}
+
 // WithCurrency transforms this Money to a different Currency
 func (m Money) WithCurrency(c currency.Currency) Money {
 	return Make(m.amount, c)
 }
 
-func (m Money) panicIfDifferentCurrency(c currency.Currency) {
-	if !m.currency.Equals(c) {
-		panic(fmt.Errorf("expected currency %s, got %s", m.currency.Code, c.Code))
-	}
-}
-
 // Math, aka, here be dragons
 
 // Cmp comparies monies. errors if currency is different.
@@ -174,11 +169,14 @@ func (m Money) Sub(other Money) (Money, error) {
 	return Make(m.amount.Sub(other.amount), m.currency), nil
 }
 
-// Div divides monies. errors if currency is different.
+// Div divides monies. errors if currency is different or other is zero.
 func (m Money) Div(other Money) (Money, error) {
 	if !m.currency.Equals(other.currency) {
 		return Zero(m.currency), &ErrDifferentCurrency{m.currency, other.currency}
 	}
+	if other.IsZero() {
+		return Zero(m.currency), ErrDivisionByZero
+	}
 	return Make(m.amount.Div(other.amount), m.currency), nil
 }
 
@@ -190,26 +188,32 @@ func (m Money) Mul(other Money) (Money, error) {
 	return Make(m.amount.Mul(other.amount), m.currency), nil
 }
 
-// UnmarshalJSON implements the json.Unmarshaler interface.
-func (m *Money) UnmarshalJSON(data []byte) (err error) {
-	*m, err = Parse(strings.Trim(string(data), `"`))
-	return
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts the
+// string form "USD 10.00" as well as the object forms of JSONObject and
+// JSONMinor. null leaves m untouched.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(m, data)
 }
 
-// MarshalJSON implements the json.Marshaler interface.
+// MarshalJSON implements the json.Marshaler interface. It emits the string
+// form "USD 10.00"; wrap Money in JSONObject or JSONMinor for object forms.
 func (m Money) MarshalJSON() ([]byte, error) {
 	return []byte(`"` + m.String() + `"`), nil
 }
 
 // Scan implements the sql.Scanner interface for database deserialization.
+// Text is parsed as by Parse. Bare numbers carry no currency, so they're
+// scanned with ScanIn, NumericColumns or MinorColumns instead.
 func (m *Money) Scan(value interface{}) (err error) {
-	asBytes, ok := value.([]byte)
-	if !ok {
-		return errors.New("scan value was not []byte")
+	switch v := value.(type) {
+	case []byte:
+		*m, err = Parse(string(v))
+	case string:
+		*m, err = Parse(v)
+	default:
+		return &ErrScan{value}
 	}
-
-	*m, err = Parse(string(asBytes))
-	return err
+	return
 }
 
 // Value implements the driver.Valuer interface for database serialization.
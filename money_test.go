@@ -9,11 +9,6 @@ import (
 	"github.com/shopspring/decimal"
 )
 
-var (
-	// XTS is an ISO code reserved for use in testing
-	XTS = "XTS"
-)
-
 func d(value string) decimal.Decimal {
 	if v, err := decimal.NewFromString(value); err != nil {
 		panic(err)
@@ -88,6 +83,12 @@ func TestString(t *testing.T) {
 		{Make(d("-100"), USD), "USD -100.00"},
 		{Make(d("1000"), USD), "USD 1000.00"},
 		{Make(d("105500"), USD), "USD 105500.00"},
+		{Make(d("10.5"), USD), "USD 10.50"},
+		{Make(d("10.005"), USD), "USD 10.005"},
+		{Make(d("1.5"), XAU), "XAU 1.5"},
+		{Make(d("100"), XAU), "XAU 100"},
+		{Make(d("5000"), XAF), "XAF 5000"},
+		{Make(d("10"), XTS), "XTS 10"},
 	}
 
 	for _, m := range monies {
@@ -97,6 +98,26 @@ func TestString(t *testing.T) {
 	}
 }
 
+func TestRound(t *testing.T) {
+	var monies = []struct {
+		money    Money
+		expected Money
+	}{
+		{Make(d("10.005"), USD), Make(d("10.01"), USD)},
+		{Make(d("-10.005"), USD), Make(d("-10.01"), USD)},
+		{Make(d("10.004"), USD), Make(d("10"), USD)},
+		{Make(d("5000.5"), XAF), Make(d("5001"), XAF)},
+		{Make(d("1.23456"), XAU), Make(d("1.23456"), XAU)},
+		{Make(d("0.5"), XDR), Make(d("0.5"), XDR)},
+	}
+
+	for _, m := range monies {
+		if actual := m.money.Round(); !actual.Equals(m.expected) {
+			t.Errorf("Money.Round() => %s, expected %s", actual, m.expected)
+		}
+	}
+}
+
 func TestParse(t *testing.T) {
 	var monies = []struct {
 		money string
@@ -104,7 +125,8 @@ func TestParse(t *testing.T) {
 	}{
 		{Make(d("55"), USD).String(), nil},
 		{Make(d("5555"), USD).String(), nil},
-		{"XXX 55.00", errors.New("could not find currency XXX")},
+		{"ZZZ 55.00", errors.New("could not find currency ZZZ")},
+		{Make(d("1.5"), XAU).String(), nil},
 	}
 
 	for _, m := range monies {
@@ -325,7 +347,22 @@ func TestCmp(t *testing.T) {
 }
 
 func TestMinor(t *testing.T) {
-	t.Skip("Need to implement minor/exponent")
+	var monies = []struct {
+		money    Money
+		expected decimal.Decimal
+	}{
+		{Make(d("10"), USD), d("1000")},
+		{Make(d("10.05"), USD), d("1005")},
+		{Make(d("-0.005"), USD), d("-0.5")},
+		{Make(d("5000"), XAF), d("5000")},
+		{Make(d("1.5"), XAU), d("1.5")},
+	}
+
+	for _, m := range monies {
+		if actual := m.money.AmountMinor(); !actual.Equals(m.expected) {
+			t.Errorf("Money.AmountMinor() => %s, expected %s", actual, m.expected)
+		}
+	}
 }
 
 func TestJSON(t *testing.T) {
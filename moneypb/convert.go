@@ -0,0 +1,87 @@
+// Package moneypb converts money.Money to and from Protocol Buffers: the
+// standard google.type.Money, which is limited to nanos, and Money from
+// money.proto, which keeps arbitrary decimal precision.
+package moneypb
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/FoxComm/money"
+	"github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+	gmoney "google.golang.org/genproto/googleapis/type/money"
+)
+
+// ErrInvalidMoney is returned for google.type.Money whose nanos are out of
+// range or have a different sign than units, or for nil messages
+var ErrInvalidMoney = errors.New("invalid google.type.Money")
+
+const nanosPerUnit = 1000000000
+
+// ToGoogle converts to google.type.Money. errors with money.ErrPrecisionLoss
+// if the amount has more than 9 decimals, or money.ErrOverflow if its units
+// don't fit an int64.
+func ToGoogle(m money.Money) (*gmoney.Money, error) {
+	nanos := m.Amount().Shift(9)
+	if !nanos.Equals(nanos.Truncate(0)) {
+		return nil, money.ErrPrecisionLoss
+	}
+
+	units, rem := new(big.Int).QuoRem(nanos.BigInt(), big.NewInt(nanosPerUnit), new(big.Int))
+	if !units.IsInt64() {
+		return nil, money.ErrOverflow
+	}
+	return &gmoney.Money{
+		CurrencyCode: m.Currency().Code,
+		Units:        units.Int64(),
+		Nanos:        int32(rem.Int64()),
+	}, nil
+}
+
+// FromGoogle converts google.type.Money. errors with ErrInvalidMoney, or
+// money.ErrUnknownCurrency.
+func FromGoogle(g *gmoney.Money) (money.Money, error) {
+	if g == nil || g.Nanos <= -nanosPerUnit || g.Nanos >= nanosPerUnit ||
+		g.Units > 0 && g.Nanos < 0 || g.Units < 0 && g.Nanos > 0 {
+		return money.Money{}, ErrInvalidMoney
+	}
+
+	c, err := lookup(g.CurrencyCode)
+	if err != nil {
+		return money.Money{}, err
+	}
+	amount := decimal.New(g.Units, 0).Add(decimal.New(int64(g.Nanos), -9))
+	return money.Make(amount, c), nil
+}
+
+// ToProto converts to Money, which never fails
+func ToProto(m money.Money) *Money {
+	return &Money{CurrencyCode: m.Currency().Code, Amount: m.Amount().String()}
+}
+
+// FromProto converts Money. errors with ErrInvalidMoney for nil, or
+// money.ErrParse or money.ErrUnknownCurrency.
+func FromProto(p *Money) (money.Money, error) {
+	if p == nil {
+		return money.Money{}, ErrInvalidMoney
+	}
+	if strings.ContainsAny(p.Amount, "eE") {
+		return money.Money{}, &money.ErrParse{Input: p.Amount}
+	}
+
+	c, err := lookup(p.CurrencyCode)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return money.MakeFromString(p.Amount, c)
+}
+
+func lookup(code string) (currency.Currency, error) {
+	c, ok := currency.Table[code]
+	if !ok {
+		return c, &money.ErrUnknownCurrency{Code: code}
+	}
+	return c, nil
+}
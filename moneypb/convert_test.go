@@ -0,0 +1,109 @@
+package moneypb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/FoxComm/money"
+	. "github.com/FoxComm/money/currency"
+	gmoney "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/proto"
+)
+
+func m(amount string, c Currency) money.Money {
+	return money.MustMakeFromString(amount, c)
+}
+
+func TestGoogle(t *testing.T) {
+	var monies = []struct {
+		money  money.Money
+		google *gmoney.Money
+	}{
+		{m("10.50", USD), &gmoney.Money{CurrencyCode: "USD", Units: 10, Nanos: 500000000}},
+		{m("-1.75", USD), &gmoney.Money{CurrencyCode: "USD", Units: -1, Nanos: -750000000}},
+		{m("-0.75", USD), &gmoney.Money{CurrencyCode: "USD", Units: 0, Nanos: -750000000}},
+		{m("0.000000001", USD), &gmoney.Money{CurrencyCode: "USD", Nanos: 1}},
+		{m("5000", XAF), &gmoney.Money{CurrencyCode: "XAF", Units: 5000}},
+		{m("9223372036854775807.999999999", USD), &gmoney.Money{CurrencyCode: "USD", Units: 9223372036854775807, Nanos: 999999999}},
+	}
+
+	for _, mm := range monies {
+		g, err := ToGoogle(mm.money)
+		if err != nil || !proto.Equal(g, mm.google) {
+			t.Errorf("ToGoogle(%s) => (%v, %v), expected %v", mm.money, g, err, mm.google)
+		}
+
+		back, err := FromGoogle(mm.google)
+		if err != nil || !back.Equals(mm.money) {
+			t.Errorf("FromGoogle(%v) => (%s, %v), expected %s", mm.google, back, err, mm.money)
+		}
+	}
+}
+
+func TestToGoogleErrors(t *testing.T) {
+	if _, err := ToGoogle(m("0.0000000001", USD)); err != money.ErrPrecisionLoss {
+		t.Errorf("ToGoogle() => %v, expected ErrPrecisionLoss", err)
+	}
+	if _, err := ToGoogle(m("9223372036854775808", USD)); err != money.ErrOverflow {
+		t.Errorf("ToGoogle() => %v, expected ErrOverflow", err)
+	}
+}
+
+func TestFromGoogleErrors(t *testing.T) {
+	var invalid = []*gmoney.Money{
+		nil,
+		{CurrencyCode: "USD", Units: 1, Nanos: -1},
+		{CurrencyCode: "USD", Units: -1, Nanos: 1},
+		{CurrencyCode: "USD", Nanos: 1000000000},
+		{CurrencyCode: "USD", Nanos: -1000000000},
+	}
+
+	for _, g := range invalid {
+		if _, err := FromGoogle(g); err != ErrInvalidMoney {
+			t.Errorf("FromGoogle(%v) => %v, expected ErrInvalidMoney", g, err)
+		}
+	}
+
+	var unknown *money.ErrUnknownCurrency
+	if _, err := FromGoogle(&gmoney.Money{CurrencyCode: "ZZZ", Units: 1}); !errors.As(err, &unknown) {
+		t.Errorf("FromGoogle() => %v, expected ErrUnknownCurrency", err)
+	}
+}
+
+func TestProto(t *testing.T) {
+	monies := []money.Money{m("10.50", USD), m("-0.0000000000001", USD), m("1.5", XAU), m("0", USD)}
+
+	for _, mm := range monies {
+		byt, err := proto.Marshal(ToProto(mm))
+		if err != nil {
+			t.Errorf("proto.Marshal(%s) => unexpected error %s", mm, err)
+			continue
+		}
+
+		var p Money
+		if err := proto.Unmarshal(byt, &p); err != nil {
+			t.Errorf("proto.Unmarshal(%s) => unexpected error %s", mm, err)
+		}
+		if back, err := FromProto(&p); err != nil || !back.Equals(mm) {
+			t.Errorf("FromProto(%v) => (%s, %v), expected %s", &p, back, err, mm)
+		}
+	}
+}
+
+func TestFromProtoErrors(t *testing.T) {
+	if _, err := FromProto(nil); err != ErrInvalidMoney {
+		t.Errorf("FromProto(nil) => %v, expected ErrInvalidMoney", err)
+	}
+
+	var parse *money.ErrParse
+	for _, amount := range []string{"1e3", "ten", ""} {
+		if _, err := FromProto(&Money{CurrencyCode: "USD", Amount: amount}); !errors.As(err, &parse) {
+			t.Errorf("FromProto(%q) => %v, expected ErrParse", amount, err)
+		}
+	}
+
+	var unknown *money.ErrUnknownCurrency
+	if _, err := FromProto(&Money{CurrencyCode: "ZZZ", Amount: "1"}); !errors.As(err, &unknown) {
+		t.Errorf("FromProto() => %v, expected ErrUnknownCurrency", err)
+	}
+}
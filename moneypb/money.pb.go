@@ -0,0 +1,137 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v5.29.3
+// source: moneypb/money.proto
+
+package moneypb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Money is an amount of a currency with arbitrary decimal precision, unlike
+// google.type.Money which is limited to nanos. Use it for unit prices and FX
+// rates which need more than 9 decimal places.
+type Money struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The three-letter currency code defined in ISO 4217, e.g. "USD".
+	CurrencyCode string `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// The amount as a decimal string in the currency's major unit, e.g.
+	// "10.50" or "-0.0000000001". Exponents aren't allowed.
+	Amount        string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_moneypb_money_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_moneypb_money_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_moneypb_money_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Money) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+var File_moneypb_money_proto protoreflect.FileDescriptor
+
+const file_moneypb_money_proto_rawDesc = "" +
+	"\n" +
+	"\x13moneypb/money.proto\x12\x10foxcomm.money.v1\"D\n" +
+	"\x05Money\x12#\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amountB\"Z github.com/FoxComm/money/moneypbb\x06proto3"
+
+var (
+	file_moneypb_money_proto_rawDescOnce sync.Once
+	file_moneypb_money_proto_rawDescData []byte
+)
+
+func file_moneypb_money_proto_rawDescGZIP() []byte {
+	file_moneypb_money_proto_rawDescOnce.Do(func() {
+		file_moneypb_money_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_moneypb_money_proto_rawDesc), len(file_moneypb_money_proto_rawDesc)))
+	})
+	return file_moneypb_money_proto_rawDescData
+}
+
+var file_moneypb_money_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_moneypb_money_proto_goTypes = []any{
+	(*Money)(nil), // 0: foxcomm.money.v1.Money
+}
+var file_moneypb_money_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_moneypb_money_proto_init() }
+func file_moneypb_money_proto_init() {
+	if File_moneypb_money_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_moneypb_money_proto_rawDesc), len(file_moneypb_money_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_moneypb_money_proto_goTypes,
+		DependencyIndexes: file_moneypb_money_proto_depIdxs,
+		MessageInfos:      file_moneypb_money_proto_msgTypes,
+	}.Build()
+	File_moneypb_money_proto = out.File
+	file_moneypb_money_proto_goTypes = nil
+	file_moneypb_money_proto_depIdxs = nil
+}
@@ -0,0 +1,149 @@
+// Package mongodb stores money.Money in MongoDB as a subdocument with a
+// Decimal128 amount and a currency code, e.g.
+//
+//	{"amount": NumberDecimal("10.50"), "currency": "USD"}
+//
+// so prices sort and range query by amount. Use Document for single fields,
+// or NewRegistry to encode every money.Money through the official driver:
+//
+//	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri).SetRegistry(mongodb.NewRegistry()))
+package mongodb
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/FoxComm/money"
+	"github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	// ErrDecimal128 is returned for amounts with more than 34 significant
+	// digits, or exponents outside Decimal128's range
+	ErrDecimal128 = errors.New("amount doesn't fit a Decimal128")
+
+	// ErrInvalidDocument is returned when decoding a value which isn't a
+	// subdocument with a numeric amount and a string currency
+	ErrInvalidDocument = errors.New("invalid money document")
+)
+
+var moneyType = reflect.TypeOf(money.Money{})
+
+// Document maps Money to a subdocument with a Decimal128 amount and a
+// currency code
+type Document struct {
+	money.Money
+}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface. errors with
+// ErrDecimal128.
+func (d Document) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	amount := d.Amount()
+	dec, ok := primitive.ParseDecimal128FromBigInt(amount.Coefficient(), int(amount.Exponent()))
+	if !ok {
+		return 0, nil, ErrDecimal128
+	}
+	return bson.MarshalValue(bson.D{{Key: "amount", Value: dec}, {Key: "currency", Value: d.Currency().Code}})
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface. Besides
+// Decimal128, amounts may be strings, int32 or int64. errors with
+// ErrInvalidDocument, money.ErrParse or money.ErrUnknownCurrency.
+func (d *Document) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if t != bsontype.EmbeddedDocument {
+		return ErrInvalidDocument
+	}
+	doc := bson.Raw(data)
+
+	code, ok := doc.Lookup("currency").StringValueOK()
+	if !ok {
+		return ErrInvalidDocument
+	}
+	c, ok := currency.Table[code]
+	if !ok {
+		return &money.ErrUnknownCurrency{Code: code}
+	}
+
+	amount, err := decodeAmount(doc.Lookup("amount"))
+	if err != nil {
+		return err
+	}
+	d.Money = money.Make(amount, c)
+	return nil
+}
+
+func decodeAmount(v bson.RawValue) (decimal.Decimal, error) {
+	switch v.Type {
+	case bsontype.Decimal128:
+		coef, exp, err := v.Decimal128().BigInt()
+		if err != nil {
+			return decimal.Decimal{}, &money.ErrParse{Input: v.Decimal128().String(), Err: err}
+		}
+		return decimal.NewFromBigInt(coef, int32(exp)), nil
+	case bsontype.String:
+		amount, err := decimal.NewFromString(v.StringValue())
+		if err != nil {
+			return amount, &money.ErrParse{Input: v.StringValue(), Err: err}
+		}
+		return amount, nil
+	case bsontype.Int32:
+		return decimal.New(int64(v.Int32()), 0), nil
+	case bsontype.Int64:
+		return decimal.New(v.Int64(), 0), nil
+	}
+	return decimal.Decimal{}, ErrInvalidDocument
+}
+
+// Register adds encoding and decoding money.Money as Document to r
+func Register(r *bsoncodec.Registry) {
+	r.RegisterTypeEncoder(moneyType, bsoncodec.ValueEncoderFunc(encodeMoney))
+	r.RegisterTypeDecoder(moneyType, bsoncodec.ValueDecoderFunc(decodeMoney))
+}
+
+// NewRegistry is bson.NewRegistry with Register applied, to be set on the
+// driver's client options
+func NewRegistry() *bsoncodec.Registry {
+	r := bson.NewRegistry()
+	Register(r)
+	return r
+}
+
+func encodeMoney(_ bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != moneyType {
+		return bsoncodec.ValueEncoderError{Name: "encodeMoney", Types: []reflect.Type{moneyType}, Received: val}
+	}
+
+	t, data, err := Document{val.Interface().(money.Money)}.MarshalBSONValue()
+	if err != nil {
+		return err
+	}
+	return bsonrw.Copier{}.CopyValueFromBytes(vw, t, data)
+}
+
+func decodeMoney(_ bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != moneyType {
+		return bsoncodec.ValueDecoderError{Name: "decodeMoney", Types: []reflect.Type{moneyType}, Received: val}
+	}
+
+	if vr.Type() == bsontype.Null {
+		val.Set(reflect.Zero(moneyType))
+		return vr.ReadNull()
+	}
+
+	t, data, err := bsonrw.Copier{}.CopyValueToBytes(vr)
+	if err != nil {
+		return err
+	}
+	var d Document
+	if err := d.UnmarshalBSONValue(t, data); err != nil {
+		return err
+	}
+	val.Set(reflect.ValueOf(d.Money))
+	return nil
+}
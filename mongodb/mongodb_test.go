@@ -0,0 +1,132 @@
+package mongodb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/FoxComm/money"
+	. "github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func m(amount string, c Currency) money.Money {
+	return money.MustMakeFromString(amount, c)
+}
+
+type product struct {
+	SKU   string      `bson:"sku"`
+	Price money.Money `bson:"price"`
+}
+
+func TestDocument(t *testing.T) {
+	monies := []money.Money{m("10.50", USD), m("-0.333", USD), m("5000", XAF), m("1.5", XAU), m("0", USD)}
+
+	for _, expected := range monies {
+		byt, err := bson.Marshal(bson.M{"price": Document{expected}})
+		if err != nil {
+			t.Errorf("bson.Marshal(%s) => unexpected error %s", expected, err)
+			continue
+		}
+
+		amount := bson.Raw(byt).Lookup("price", "amount")
+		if amount.Type != bsontype.Decimal128 || !decimal.RequireFromString(amount.Decimal128().String()).Equals(expected.Amount()) {
+			t.Errorf("bson.Marshal(%s) => amount %s, expected Decimal128 %s", expected, amount, expected.Amount())
+		}
+		if code := bson.Raw(byt).Lookup("price", "currency").StringValue(); code != expected.Currency().Code {
+			t.Errorf("bson.Marshal(%s) => currency %s, expected %s", expected, code, expected.Currency().Code)
+		}
+
+		var actual struct {
+			Price Document `bson:"price"`
+		}
+		if err := bson.Unmarshal(byt, &actual); err != nil || !actual.Price.Equals(expected) {
+			t.Errorf("bson.Unmarshal() => (%s, %v), expected %s", actual.Price.Money, err, expected)
+		}
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	reg := NewRegistry()
+	expected := product{"sku-1", m("10.50", USD)}
+
+	byt, err := bson.MarshalWithRegistry(reg, expected)
+	if err != nil {
+		t.Fatalf("bson.MarshalWithRegistry() => unexpected error %s", err)
+	}
+	if amount := bson.Raw(byt).Lookup("price", "amount"); amount.Type != bsontype.Decimal128 {
+		t.Errorf("bson.MarshalWithRegistry() => amount %s, expected Decimal128", amount)
+	}
+
+	var actual product
+	if err := bson.UnmarshalWithRegistry(reg, byt, &actual); err != nil || actual.SKU != expected.SKU || !actual.Price.Equals(expected.Price) {
+		t.Errorf("bson.UnmarshalWithRegistry() => (%+v, %v), expected %+v", actual, err, expected)
+	}
+
+	byt, _ = bson.Marshal(bson.M{"sku": "sku-2", "price": nil})
+	if err := bson.UnmarshalWithRegistry(reg, byt, &actual); err != nil || !actual.Price.Equals(money.Money{}) {
+		t.Errorf("bson.UnmarshalWithRegistry(null) => (%+v, %v), expected zero Money", actual, err)
+	}
+}
+
+func TestUnmarshalAmounts(t *testing.T) {
+	dec, _ := primitive.ParseDecimal128("10.50")
+	var docs = []struct {
+		doc      bson.M
+		expected money.Money
+	}{
+		{bson.M{"amount": dec, "currency": "USD"}, m("10.50", USD)},
+		{bson.M{"amount": "10.50", "currency": "USD"}, m("10.50", USD)},
+		{bson.M{"amount": int32(10), "currency": "USD"}, m("10", USD)},
+		{bson.M{"amount": int64(10), "currency": "USD"}, m("10", USD)},
+	}
+
+	for _, d := range docs {
+		byt, _ := bson.Marshal(bson.M{"price": d.doc})
+		var actual product
+		if err := bson.UnmarshalWithRegistry(NewRegistry(), byt, &actual); err != nil || !actual.Price.Equals(d.expected) {
+			t.Errorf("bson.Unmarshal(%v) => (%s, %v), expected %s", d.doc, actual.Price, err, d.expected)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	big := money.MustMakeFromString("12345678901234567890123456789012345", USD)
+	if _, err := bson.Marshal(bson.M{"price": Document{big}}); !errors.Is(err, ErrDecimal128) {
+		t.Errorf("bson.Marshal(%s) => %v, expected ErrDecimal128", big, err)
+	}
+
+	nan, _ := primitive.ParseDecimal128("NaN")
+	var invalid = []interface{}{
+		"USD 10.50",
+		bson.M{"amount": "10"},
+		bson.M{"amount": 10.5, "currency": "USD"},
+		bson.M{"amount": true, "currency": "USD"},
+		bson.M{"currency": "USD"},
+	}
+	for _, v := range invalid {
+		byt, _ := bson.Marshal(bson.M{"price": v})
+		var actual product
+		if err := bson.UnmarshalWithRegistry(NewRegistry(), byt, &actual); !errors.Is(err, ErrInvalidDocument) {
+			t.Errorf("bson.Unmarshal(%v) => %v, expected ErrInvalidDocument", v, err)
+		}
+	}
+
+	var parse *money.ErrParse
+	for _, amount := range []interface{}{nan, "ten"} {
+		byt, _ := bson.Marshal(bson.M{"price": bson.M{"amount": amount, "currency": "USD"}})
+		var actual product
+		if err := bson.UnmarshalWithRegistry(NewRegistry(), byt, &actual); !errors.As(err, &parse) {
+			t.Errorf("bson.Unmarshal(%v) => %v, expected ErrParse", amount, err)
+		}
+	}
+
+	var unknown *money.ErrUnknownCurrency
+	byt, _ := bson.Marshal(bson.M{"price": bson.M{"amount": "10", "currency": "ZZZ"}})
+	var actual product
+	if err := bson.UnmarshalWithRegistry(NewRegistry(), byt, &actual); !errors.As(err, &unknown) {
+		t.Errorf("bson.Unmarshal() => %v, expected ErrUnknownCurrency", err)
+	}
+}
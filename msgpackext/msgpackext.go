@@ -0,0 +1,46 @@
+// Package msgpackext registers money.Money as a MessagePack extension type
+// with github.com/vmihailenco/msgpack, so Money round-trips losslessly
+// instead of being reparsed from its string form. Import it for the side
+// effect:
+//
+//	import _ "github.com/FoxComm/money/msgpackext"
+//
+// Money is encoded as extension ExtID whose data is Money's binary format,
+// see money.Money.MarshalBinary: a version byte, the ISO 4217 numeric
+// currency, and the amount's exponent and mantissa as varints. USD 10.50 is
+//
+//	c7 06 4d 01 c8 06 03 b4 10
+package msgpackext
+
+import (
+	"reflect"
+
+	"github.com/FoxComm/money"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// ExtID is the MessagePack extension type of money.Money, 'M'
+const ExtID int8 = 77
+
+func init() {
+	msgpack.RegisterExtEncoder(ExtID, money.Money{}, encode)
+	msgpack.RegisterExtDecoder(ExtID, money.Money{}, decode)
+}
+
+func encode(_ *msgpack.Encoder, v reflect.Value) ([]byte, error) {
+	return v.Interface().(money.Money).MarshalBinary()
+}
+
+func decode(d *msgpack.Decoder, v reflect.Value, extLen int) error {
+	data := make([]byte, extLen)
+	if err := d.ReadFull(data); err != nil {
+		return err
+	}
+
+	var m money.Money
+	if err := m.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	v.Set(reflect.ValueOf(m))
+	return nil
+}
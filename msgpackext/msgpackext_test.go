@@ -0,0 +1,86 @@
+package msgpackext
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/FoxComm/money"
+	. "github.com/FoxComm/money/currency"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func m(amount string, c Currency) money.Money {
+	return money.MustMakeFromString(amount, c)
+}
+
+func TestVectors(t *testing.T) {
+	var vectors = []struct {
+		money    money.Money
+		expected string
+	}{
+		{m("10.50", USD), "c7064d01c80603b410"},
+		{m("-0.05", USD), "c7054d01c8060309"},
+		{m("5000", XAF), "c7064d01b60700904e"},
+		{m("1.5", XAU), "c7054d01bf07011e"},
+		{m("123456789012345678901234567890.5", USD), "c7134d01c80601f2b0b3e7e1d3e486c58efda9a3e507"},
+	}
+
+	for _, v := range vectors {
+		byt, err := msgpack.Marshal(v.money)
+		if err != nil || hex.EncodeToString(byt) != v.expected {
+			t.Errorf("msgpack.Marshal(%s) => (%x, %v), expected %s", v.money, byt, err, v.expected)
+		}
+
+		var actual money.Money
+		if err := msgpack.Unmarshal(byt, &actual); err != nil || !actual.Equals(v.money) {
+			t.Errorf("msgpack.Unmarshal(%x) => (%s, %v), expected %s", byt, actual, err, v.money)
+		}
+	}
+}
+
+func TestStructs(t *testing.T) {
+	type event struct {
+		ID     string
+		Amount money.Money
+		Fees   []money.Money
+	}
+	expected := event{"evt-1", m("10.50", USD), []money.Money{m("0.30", USD), m("0.01", USD)}}
+
+	byt, err := msgpack.Marshal(expected)
+	if err != nil {
+		t.Fatalf("msgpack.Marshal() => unexpected error %s", err)
+	}
+
+	var actual event
+	if err := msgpack.Unmarshal(byt, &actual); err != nil || actual.ID != expected.ID ||
+		!actual.Amount.Equals(expected.Amount) || len(actual.Fees) != 2 || !actual.Fees[1].Equals(expected.Fees[1]) {
+		t.Errorf("msgpack.Unmarshal() => (%+v, %v), expected %+v", actual, err, expected)
+	}
+
+	var generic interface{}
+	if err := msgpack.Unmarshal(must(msgpack.Marshal(m("10.50", USD))), &generic); err != nil {
+		t.Errorf("msgpack.Unmarshal(interface{}) => unexpected error %s", err)
+	} else if actual, ok := generic.(money.Money); !ok || !actual.Equals(m("10.50", USD)) {
+		t.Errorf("msgpack.Unmarshal(interface{}) => %#v, expected USD 10.50", generic)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	var actual money.Money
+	if err := msgpack.Unmarshal(must(hex.DecodeString("c7024d0200")), &actual); !errors.Is(err, money.ErrInvalidEncoding) {
+		t.Errorf("msgpack.Unmarshal() => %v, expected ErrInvalidEncoding", err)
+	}
+
+	var unknown *money.ErrUnknownCurrency
+	if err := msgpack.Unmarshal(must(hex.DecodeString("c7044d01010000")), &actual); !errors.As(err, &unknown) {
+		t.Errorf("msgpack.Unmarshal() => %v, expected ErrUnknownCurrency", err)
+	}
+}
+
+func must(byt []byte, err error) []byte {
+	if err != nil {
+		panic(err)
+	}
+	return byt
+}
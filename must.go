@@ -0,0 +1,59 @@
+package money
+
+import "github.com/FoxComm/money/currency"
+
+// Must* variants panic instead of returning an error. They're meant for
+// constants and tests, where an error is a programming mistake.
+
+// MustParse is like Parse but panics on error
+func MustParse(str string) Money {
+	m, err := Parse(str)
+	return must(m, err)
+}
+
+// MustMakeFromString is like MakeFromString but panics on error
+func MustMakeFromString(amount string, c currency.Currency) Money {
+	m, err := MakeFromString(amount, c)
+	return must(m, err)
+}
+
+// MustAdd is like Add but panics on error
+func (m Money) MustAdd(other Money) Money {
+	return must(m.Add(other))
+}
+
+// MustSub is like Sub but panics on error
+func (m Money) MustSub(other Money) Money {
+	return must(m.Sub(other))
+}
+
+// MustMul is like Mul but panics on error
+func (m Money) MustMul(other Money) Money {
+	return must(m.Mul(other))
+}
+
+// MustDiv is like Div but panics on error
+func (m Money) MustDiv(other Money) Money {
+	return must(m.Div(other))
+}
+
+// MustCmp is like Cmp but panics on error
+func (m Money) MustCmp(other Money) int {
+	cmp, err := m.Cmp(other)
+	if err != nil {
+		panic(err)
+	}
+	return cmp
+}
+
+// MustResult is like Result but panics on error
+func (c Calc) MustResult() Money {
+	return must(c.Result())
+}
+
+func must(m Money, err error) Money {
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
@@ -0,0 +1,50 @@
+package money
+
+import (
+	"testing"
+
+	. "github.com/FoxComm/money/currency"
+)
+
+func expectPanic(t *testing.T, funcName string, f func()) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("%s => expected panic", funcName)
+		}
+	}()
+	f()
+}
+
+func TestMust(t *testing.T) {
+	ten := MustParse("USD 10.00")
+	if !ten.Equals(Make(d("10"), USD)) {
+		t.Errorf("MustParse() => %s, expected USD 10.00", ten)
+	}
+
+	two := MustMakeFromString("2", USD)
+	if sum := ten.MustAdd(two); !sum.Equals(Make(d("12"), USD)) {
+		t.Errorf("Money.MustAdd() => %s, expected USD 12.00", sum)
+	}
+	if diff := ten.MustSub(two); !diff.Equals(Make(d("8"), USD)) {
+		t.Errorf("Money.MustSub() => %s, expected USD 8.00", diff)
+	}
+	if product := ten.MustMul(two); !product.Equals(Make(d("20"), USD)) {
+		t.Errorf("Money.MustMul() => %s, expected USD 20.00", product)
+	}
+	if quotient := ten.MustDiv(two); !quotient.Equals(Make(d("5"), USD)) {
+		t.Errorf("Money.MustDiv() => %s, expected USD 5.00", quotient)
+	}
+	if cmp := ten.MustCmp(two); cmp != 1 {
+		t.Errorf("Money.MustCmp() => %d, expected 1", cmp)
+	}
+
+	mxn := Make(d("1"), MXN)
+	expectPanic(t, "MustParse()", func() { MustParse("ZZZ 1.00") })
+	expectPanic(t, "MustMakeFromString()", func() { MustMakeFromString("ten", USD) })
+	expectPanic(t, "Money.MustAdd()", func() { ten.MustAdd(mxn) })
+	expectPanic(t, "Money.MustSub()", func() { ten.MustSub(mxn) })
+	expectPanic(t, "Money.MustMul()", func() { ten.MustMul(mxn) })
+	expectPanic(t, "Money.MustDiv()", func() { ten.MustDiv(Zero(USD)) })
+	expectPanic(t, "Money.MustCmp()", func() { ten.MustCmp(mxn) })
+	expectPanic(t, "Calc.MustResult()", func() { Chain(ten).Add(mxn).MustResult() })
+}
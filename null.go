@@ -0,0 +1,90 @@
+package money
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// NullMoney represents Money that may be null, e.g. an optional price column.
+// It follows sql.NullString: Valid is true if Money is not NULL.
+type NullMoney struct {
+	Money Money
+	Valid bool
+}
+
+// String is Money's String, or "NULL" if not valid
+func (n NullMoney) String() string {
+	if !n.Valid {
+		return "NULL"
+	}
+	return n.Money.String()
+}
+
+// Scan implements the sql.Scanner interface for database deserialization.
+func (n *NullMoney) Scan(value interface{}) error {
+	if value == nil {
+		*n = NullMoney{}
+		return nil
+	}
+	if err := n.Money.Scan(value); err != nil {
+		*n = NullMoney{}
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database serialization.
+func (n NullMoney) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Money.Value()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. null is not valid;
+// anything else decodes as for Money.
+func (n *NullMoney) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*n = NullMoney{}
+		return nil
+	}
+	var m Money
+	if err := m.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*n = NullMoney{m, true}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n NullMoney) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Money.MarshalJSON()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for XML
+// deserialization. Empty text is not valid.
+func (n *NullMoney) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*n = NullMoney{}
+		return nil
+	}
+	var m Money
+	if err := m.UnmarshalText(text); err != nil {
+		return err
+	}
+	*n = NullMoney{m, true}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for XML
+// serialization. Money that is not valid is empty text.
+func (n NullMoney) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.Money.MarshalText()
+}
@@ -0,0 +1,87 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/FoxComm/money/currency"
+)
+
+func TestNullMoneyScan(t *testing.T) {
+	var scans = []struct {
+		value    interface{}
+		expected NullMoney
+	}{
+		{nil, NullMoney{}},
+		{[]byte("USD 10.00"), NullMoney{Make(d("10"), USD), true}},
+	}
+
+	for _, s := range scans {
+		n := NullMoney{Make(d("1"), CAD), true}
+		if err := n.Scan(s.value); err != nil || n.Valid != s.expected.Valid || !n.Money.Equals(s.expected.Money) {
+			t.Errorf("NullMoney.Scan(%v) => (%s, %v), expected %s", s.value, n, err, s.expected)
+		}
+	}
+
+	var n NullMoney
+	if err := n.Scan(42.5); err == nil || n.Valid {
+		t.Errorf("NullMoney.Scan(42.5) => (%s, %v), expected ErrScan", n, err)
+	}
+}
+
+func TestNullMoneyValue(t *testing.T) {
+	if v, err := (NullMoney{}).Value(); v != nil || err != nil {
+		t.Errorf("NullMoney.Value() => (%v, %v), expected (nil, nil)", v, err)
+	}
+	if v, err := (NullMoney{Make(d("10"), USD), true}).Value(); v != "USD 10.00" || err != nil {
+		t.Errorf("NullMoney.Value() => (%v, %v), expected USD 10.00", v, err)
+	}
+}
+
+func TestNullMoneyJSON(t *testing.T) {
+	var product struct {
+		Price          NullMoney `json:"price"`
+		CompareAtPrice NullMoney `json:"compare_at_price"`
+	}
+
+	input := `{"price":"USD 10.00","compare_at_price":null}`
+	if err := json.Unmarshal([]byte(input), &product); err != nil {
+		t.Fatalf("NullMoney.UnmarshalJSON() => unexpected error %s", err)
+	}
+	if !product.Price.Valid || !product.Price.Money.Equals(Make(d("10"), USD)) || product.CompareAtPrice.Valid {
+		t.Errorf("NullMoney.UnmarshalJSON(%s) => (%s, %s)", input, product.Price, product.CompareAtPrice)
+	}
+
+	byt, err := json.Marshal(product)
+	if err != nil || string(byt) != input {
+		t.Errorf("NullMoney.MarshalJSON() => (%s, %v), expected %s", byt, err, input)
+	}
+
+	var n NullMoney
+	if err := json.Unmarshal([]byte(`{"amount_minor":1000,"currency":"USD"}`), &n); err != nil || !n.Valid {
+		t.Errorf("NullMoney.UnmarshalJSON() => (%s, %v), expected USD 10.00", n, err)
+	}
+	if err := json.Unmarshal([]byte(`"ZZZ 10"`), &n); err == nil {
+		t.Errorf("NullMoney.UnmarshalJSON() => %s, expected ErrUnknownCurrency", n)
+	}
+}
+
+func TestNullMoneyText(t *testing.T) {
+	var texts = []struct {
+		text     string
+		expected NullMoney
+	}{
+		{"", NullMoney{}},
+		{"USD 10.00", NullMoney{Make(d("10"), USD), true}},
+	}
+
+	for _, tt := range texts {
+		var n NullMoney
+		if err := n.UnmarshalText([]byte(tt.text)); err != nil || n.Valid != tt.expected.Valid || !n.Money.Equals(tt.expected.Money) {
+			t.Errorf("NullMoney.UnmarshalText(%q) => (%s, %v), expected %s", tt.text, n, err, tt.expected)
+		}
+		if byt, err := tt.expected.MarshalText(); err != nil || string(byt) != tt.text {
+			t.Errorf("NullMoney.MarshalText() => (%q, %v), expected %q", byt, err, tt.text)
+		}
+	}
+}
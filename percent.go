@@ -0,0 +1,164 @@
+package money
+
+import (
+	"database/sql/driver"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+var percentUnits = []string{"bps", "%"}
+
+// Percent represents a rate such as a discount, fee or tax as an immutable
+// value. It's always created with an explicit unit, so 7% can't be mistaken
+// for 0.07 or 700%.
+type Percent struct {
+	percent decimal.Decimal
+}
+
+// MakePercent makes a Percent from an amount in percent, e.g. 7.25 for 7.25%
+func MakePercent(percent decimal.Decimal) Percent {
+	return Percent{percent}
+}
+
+// MakeBasisPoints makes a Percent from basis points, e.g. 25 for 0.25%
+func MakeBasisPoints(bps decimal.Decimal) Percent {
+	return Percent{bps.Shift(-2)}
+}
+
+// MakeRate makes a Percent from a fraction, e.g. 0.0725 for 7.25%
+func MakeRate(rate decimal.Decimal) Percent {
+	return Percent{rate.Shift(2)}
+}
+
+// ParsePercent parses "7.25%" or "25bps" into a Percent. The unit is
+// required.
+func ParsePercent(str string) (Percent, error) {
+	trimmed := strings.TrimSpace(str)
+
+	var unit string
+	for _, u := range percentUnits {
+		if strings.HasSuffix(trimmed, u) {
+			unit = u
+			break
+		}
+	}
+	if unit == "" {
+		return Percent{}, &ErrParse{Input: str}
+	}
+
+	d, err := decimal.NewFromString(strings.TrimSpace(strings.TrimSuffix(trimmed, unit)))
+	if err != nil {
+		return Percent{}, &ErrParse{str, err}
+	}
+
+	if unit == "bps" {
+		return MakeBasisPoints(d), nil
+	}
+	return MakePercent(d), nil
+}
+
+// Percent is the rate in percent, e.g. 7.25 for 7.25%
+func (p Percent) Percent() decimal.Decimal {
+	return p.percent
+}
+
+// BasisPoints is the rate in basis points, e.g. 725 for 7.25%
+func (p Percent) BasisPoints() decimal.Decimal {
+	return p.percent.Shift(2)
+}
+
+// Rate is the rate as a fraction, e.g. 0.0725 for 7.25%
+func (p Percent) Rate() decimal.Decimal {
+	return p.percent.Shift(-2)
+}
+
+// String represents the rate in percent, e.g. "7.25%"
+func (p Percent) String() string {
+	return p.percent.String() + "%"
+}
+
+// Equals is true if other Percent is the same rate
+func (p Percent) Equals(other Percent) bool {
+	return p.percent.Equals(other.percent)
+}
+
+// Cmp compares rates
+func (p Percent) Cmp(other Percent) int {
+	return p.percent.Cmp(other.percent)
+}
+
+// IsZero returns true if the rate is == 0
+func (p Percent) IsZero() bool {
+	return p.percent.Sign() == 0
+}
+
+// ApplyPercent is the given percentage of the amount, rounded half away from
+// zero to the currency's minor unit, e.g. 7.25% of USD 10.00 is USD 0.73.
+func (m Money) ApplyPercent(p Percent) Money {
+	return Make(m.amount.Mul(p.percent).Shift(-2), m.currency).Round()
+}
+
+// TakePercentOff subtracts the given percentage of the amount, e.g. 15% off
+// USD 10.00 is USD 8.50. The subtracted part is rounded as ApplyPercent does,
+// so the parts add back up to the amount.
+func (m Money) TakePercentOff(p Percent) Money {
+	return Make(m.amount.Sub(m.ApplyPercent(p).amount), m.currency)
+}
+
+// AsPercentOf is the percentage of total the amount makes up, e.g. USD 2.50
+// is 25% of USD 10.00. errors if currency is different or total is zero.
+func (m Money) AsPercentOf(total Money) (Percent, error) {
+	if !m.currency.Equals(total.currency) {
+		return Percent{}, &ErrDifferentCurrency{m.currency, total.currency}
+	}
+	if total.IsZero() {
+		return Percent{}, ErrDivisionByZero
+	}
+	return Percent{m.amount.Shift(2).Div(total.amount)}, nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *Percent) UnmarshalJSON(data []byte) (err error) {
+	if isNull(data) {
+		return nil
+	}
+	*p, err = ParsePercent(strings.Trim(string(data), `"`))
+	return
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p Percent) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + p.String() + `"`), nil
+}
+
+// Scan implements the sql.Scanner interface for database deserialization.
+func (p *Percent) Scan(value interface{}) (err error) {
+	switch v := value.(type) {
+	case []byte:
+		*p, err = ParsePercent(string(v))
+	case string:
+		*p, err = ParsePercent(v)
+	default:
+		err = &ErrScan{value}
+	}
+	return
+}
+
+// Value implements the driver.Valuer interface for database serialization.
+func (p Percent) Value() (driver.Value, error) {
+	return p.String(), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for XML
+// deserialization.
+func (p *Percent) UnmarshalText(text []byte) (err error) {
+	*p, err = ParsePercent(string(text))
+	return
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for XML
+// serialization.
+func (p Percent) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
@@ -0,0 +1,123 @@
+package money
+
+import (
+	"testing"
+
+	. "github.com/FoxComm/money/currency"
+)
+
+func TestParsePercent(t *testing.T) {
+	var percents = []struct {
+		str      string
+		percent  string
+		rate     string
+		bps      string
+		expected string
+	}{
+		{"7.25%", "7.25", "0.0725", "725", "7.25%"},
+		{" 7.25 % ", "7.25", "0.0725", "725", "7.25%"},
+		{"25bps", "0.25", "0.0025", "25", "0.25%"},
+		{"25 bps", "0.25", "0.0025", "25", "0.25%"},
+		{"-10%", "-10", "-0.1", "-1000", "-10%"},
+		{"100%", "100", "1", "10000", "100%"},
+	}
+
+	for _, p := range percents {
+		parsed, err := ParsePercent(p.str)
+		if err != nil {
+			t.Errorf("ParsePercent(%q) => unexpected error %s", p.str, err)
+			continue
+		}
+		if !parsed.Percent().Equals(d(p.percent)) || !parsed.Rate().Equals(d(p.rate)) || !parsed.BasisPoints().Equals(d(p.bps)) {
+			t.Errorf("ParsePercent(%q) => %s, %s, %sbps, expected %s, %s, %sbps",
+				p.str, parsed.Percent(), parsed.Rate(), parsed.BasisPoints(), p.percent, p.rate, p.bps)
+		}
+		if parsed.String() != p.expected {
+			t.Errorf("Percent.String() => %s, expected %s", parsed, p.expected)
+		}
+	}
+
+	for _, str := range []string{"", "0.07", "7", "%", "sevenbps"} {
+		if _, err := ParsePercent(str); err == nil {
+			t.Errorf("ParsePercent(%q) => expected ErrParse", str)
+		}
+	}
+
+	if !MakeRate(d("0.07")).Equals(MakePercent(d("7"))) || !MakeBasisPoints(d("700")).Equals(MakePercent(d("7"))) {
+		t.Errorf("MakeRate(0.07), MakeBasisPoints(700) => expected 7%%")
+	}
+}
+
+func TestApplyPercent(t *testing.T) {
+	var monies = []struct {
+		money    Money
+		percent  string
+		applied  Money
+		takenOff Money
+	}{
+		{Make(d("10"), USD), "7.25%", Make(d("0.73"), USD), Make(d("9.27"), USD)},
+		{Make(d("10"), USD), "15%", Make(d("1.50"), USD), Make(d("8.50"), USD)},
+		{Make(d("19.99"), USD), "33.333%", Make(d("6.66"), USD), Make(d("13.33"), USD)},
+		{Make(d("-10"), USD), "25bps", Make(d("-0.03"), USD), Make(d("-9.97"), USD)},
+		{Make(d("5001"), XAF), "10%", Make(d("500"), XAF), Make(d("4501"), XAF)},
+		{Make(d("3"), XAU), "10%", Make(d("0.3"), XAU), Make(d("2.7"), XAU)},
+	}
+
+	for _, m := range monies {
+		p, _ := ParsePercent(m.percent)
+		if applied := m.money.ApplyPercent(p); !applied.Equals(m.applied) {
+			t.Errorf("%s.ApplyPercent(%s) => %s, expected %s", m.money, p, applied, m.applied)
+		}
+		if takenOff := m.money.TakePercentOff(p); !takenOff.Equals(m.takenOff) {
+			t.Errorf("%s.TakePercentOff(%s) => %s, expected %s", m.money, p, takenOff, m.takenOff)
+		}
+	}
+}
+
+func TestAsPercentOf(t *testing.T) {
+	p, err := Make(d("2.50"), USD).AsPercentOf(Make(d("10"), USD))
+	if err != nil || !p.Equals(MakePercent(d("25"))) {
+		t.Errorf("Money.AsPercentOf() => (%s, %v), expected 25%%", p, err)
+	}
+
+	if _, err := Make(d("2.50"), USD).AsPercentOf(Zero(USD)); err != ErrDivisionByZero {
+		t.Errorf("Money.AsPercentOf(0) => %v, expected ErrDivisionByZero", err)
+	}
+
+	_, err = Make(d("2.50"), USD).AsPercentOf(Make(d("10"), MXN))
+	checkForZeroAndErr(t, "Money.AsPercentOf()", Zero(USD), err)
+}
+
+func TestPercentSerialization(t *testing.T) {
+	p := MakePercent(d("7.25"))
+
+	byt, err := p.MarshalJSON()
+	if err != nil || string(byt) != `"7.25%"` {
+		t.Errorf("Percent.MarshalJSON() => (%s, %v), expected \"7.25%%\"", byt, err)
+	}
+
+	var parsed Percent
+	if err := parsed.UnmarshalJSON([]byte(`"725bps"`)); err != nil || !parsed.Equals(p) {
+		t.Errorf("Percent.UnmarshalJSON() => (%s, %v), expected %s", parsed, err, p)
+	}
+
+	value, err := p.Value()
+	if err != nil || value.(string) != "7.25%" {
+		t.Errorf("Percent.Value() => (%v, %v), expected 7.25%%", value, err)
+	}
+
+	for _, v := range []interface{}{[]byte("7.25%"), "7.25%"} {
+		parsed = Percent{}
+		if err := parsed.Scan(v); err != nil || !parsed.Equals(p) {
+			t.Errorf("Percent.Scan(%v) => (%s, %v), expected %s", v, parsed, err, p)
+		}
+	}
+	if err := parsed.Scan(7.25); err == nil {
+		t.Errorf("Percent.Scan(7.25) => expected ErrScan")
+	}
+
+	parsed = Percent{}
+	if err := parsed.UnmarshalText([]byte("7.25%")); err != nil || !parsed.Equals(p) {
+		t.Errorf("Percent.UnmarshalText() => (%s, %v), expected %s", parsed, err, p)
+	}
+}
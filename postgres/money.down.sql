@@ -0,0 +1,4 @@
+DROP AGGREGATE IF EXISTS sum(money_amount);
+DROP OPERATOR IF EXISTS + (money_amount, money_amount);
+DROP FUNCTION IF EXISTS money_add(money_amount, money_amount);
+DROP TYPE IF EXISTS money_amount;
@@ -0,0 +1,32 @@
+-- money_amount stores money.Money with its currency, so amounts keep full
+-- NUMERIC precision and can't be mixed up across currencies.
+CREATE TYPE money_amount AS (
+    amount   numeric,
+    currency char(3)
+);
+
+-- money_add adds amounts of the same currency, and raises an exception for
+-- different currencies.
+CREATE FUNCTION money_add(a money_amount, b money_amount) RETURNS money_amount
+LANGUAGE plpgsql IMMUTABLE STRICT AS $$
+BEGIN
+    IF a.currency <> b.currency THEN
+        RAISE EXCEPTION 'expected currency % got %', a.currency, b.currency;
+    END IF;
+    RETURN ROW(a.amount + b.amount, a.currency)::money_amount;
+END
+$$;
+
+CREATE OPERATOR + (
+    LEFTARG = money_amount,
+    RIGHTARG = money_amount,
+    FUNCTION = money_add,
+    COMMUTATOR = +
+);
+
+-- sum totals amounts per currency, e.g.
+--   SELECT (price).currency, sum(price) FROM items GROUP BY (price).currency
+CREATE AGGREGATE sum(money_amount) (
+    SFUNC = money_add,
+    STYPE = money_amount
+);
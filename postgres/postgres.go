@@ -0,0 +1,159 @@
+// Package postgres stores money.Money in the PostgreSQL composite type
+// money_amount (amount numeric, currency char(3)), through database/sql as
+// well as pgx's binary protocol. Run Up as a migration to create the type,
+// with + and a sum aggregate which refuse to mix currencies.
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+
+	// embed the migrations
+	_ "embed"
+
+	"github.com/FoxComm/money"
+	"github.com/FoxComm/money/currency"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+// TypeName is the name of the composite type created by Up
+const TypeName = "money_amount"
+
+var (
+	// Up is the migration creating money_amount, + and sum
+	//go:embed money.up.sql
+	Up string
+
+	// Down is the migration dropping everything created by Up
+	//go:embed money.down.sql
+	Down string
+)
+
+// Register loads money_amount from the database into conn's type map, so pgx
+// encodes and decodes Composite in the binary protocol
+func Register(ctx context.Context, conn *pgx.Conn) error {
+	t, err := conn.LoadType(ctx, TypeName)
+	if err != nil {
+		return err
+	}
+	conn.TypeMap().RegisterType(t)
+	return nil
+}
+
+// Composite maps Money to money_amount
+type Composite struct {
+	money.Money
+}
+
+// Scan implements the sql.Scanner interface for database deserialization
+// of the text form, e.g. (10.50,USD).
+func (c *Composite) Scan(value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	default:
+		return &money.ErrScan{Value: value}
+	}
+
+	if len(str) < 2 || str[0] != '(' || str[len(str)-1] != ')' {
+		return &money.ErrParse{Input: str}
+	}
+	fields := strings.Split(str[1:len(str)-1], ",")
+	if len(fields) != 2 {
+		return &money.ErrParse{Input: str}
+	}
+
+	amount, err := decimal.NewFromString(strings.Trim(fields[0], `"`))
+	if err != nil {
+		return &money.ErrParse{Input: str, Err: err}
+	}
+	cur, err := lookup(strings.Trim(fields[1], `"`))
+	if err != nil {
+		return err
+	}
+	c.Money = money.Make(amount, cur)
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database serialization.
+func (c Composite) Value() (driver.Value, error) {
+	return "(" + c.Amount().String() + "," + c.Currency().Code + ")", nil
+}
+
+// IsNull implements pgtype.CompositeIndexGetter. Composite is never NULL.
+func (c Composite) IsNull() bool {
+	return false
+}
+
+// Index implements pgtype.CompositeIndexGetter.
+func (c Composite) Index(i int) any {
+	switch i {
+	case 0:
+		amount := c.Amount()
+		return pgtype.Numeric{Int: amount.Coefficient(), Exp: amount.Exponent(), Valid: true}
+	case 1:
+		return c.Currency().Code
+	}
+	return nil
+}
+
+// ScanNull implements pgtype.CompositeIndexScanner. errors since Composite
+// can't be NULL.
+func (c *Composite) ScanNull() error {
+	return &money.ErrScan{}
+}
+
+// ScanIndex implements pgtype.CompositeIndexScanner.
+func (c *Composite) ScanIndex(i int) any {
+	switch i {
+	case 0:
+		return (*amountField)(c)
+	case 1:
+		return (*currencyField)(c)
+	}
+	return nil
+}
+
+// amountField scans the amount of money_amount
+type amountField Composite
+
+// ScanNumeric implements pgtype.NumericScanner.
+func (a *amountField) ScanNumeric(v pgtype.Numeric) error {
+	if !v.Valid || v.NaN || v.InfinityModifier != pgtype.Finite {
+		return &money.ErrScan{Value: v}
+	}
+	a.Money = money.Make(decimal.NewFromBigInt(v.Int, v.Exp), a.Currency())
+	return nil
+}
+
+// currencyField scans the currency of money_amount
+type currencyField Composite
+
+// ScanText implements pgtype.TextScanner.
+func (c *currencyField) ScanText(v pgtype.Text) error {
+	if !v.Valid {
+		return &money.ErrScan{Value: v}
+	}
+	cur, err := lookup(v.String)
+	if err != nil {
+		return err
+	}
+	c.Money = c.Money.WithCurrency(cur)
+	return nil
+}
+
+// lookup finds a currency by its code, ignoring char(3) padding
+func lookup(code string) (currency.Currency, error) {
+	code = strings.TrimSpace(code)
+	cur, ok := currency.Table[code]
+	if !ok {
+		return cur, &money.ErrUnknownCurrency{Code: code}
+	}
+	return cur, nil
+}
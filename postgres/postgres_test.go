@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/FoxComm/money"
+	. "github.com/FoxComm/money/currency"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const oid = 100000
+
+func m(amount string, c Currency) money.Money {
+	return money.MustMakeFromString(amount, c)
+}
+
+// typeMap registers money_amount as LoadType would after running Up
+func typeMap(t *testing.T) *pgtype.Map {
+	tm := pgtype.NewMap()
+	numeric, ok := tm.TypeForName("numeric")
+	if !ok {
+		t.Fatal("numeric type not found")
+	}
+	bpchar, ok := tm.TypeForName("bpchar")
+	if !ok {
+		t.Fatal("bpchar type not found")
+	}
+	tm.RegisterType(&pgtype.Type{Name: TypeName, OID: oid, Codec: &pgtype.CompositeCodec{
+		Fields: []pgtype.CompositeCodecField{{Name: "amount", Type: numeric}, {Name: "currency", Type: bpchar}},
+	}})
+	return tm
+}
+
+func TestCompositeSQL(t *testing.T) {
+	var scans = []struct {
+		value    interface{}
+		expected money.Money
+	}{
+		{[]byte("(10.50,USD)"), m("10.5", USD)},
+		{"(-0.333,USD)", m("-0.333", USD)},
+		{`("1.5","XAU")`, m("1.5", XAU)},
+	}
+
+	for _, s := range scans {
+		var c Composite
+		if err := c.Scan(s.value); err != nil || !c.Equals(s.expected) {
+			t.Errorf("Composite.Scan(%s) => (%s, %v), expected %s", s.value, c.Money, err, s.expected)
+		}
+	}
+
+	if v, err := (Composite{m("10.50", USD)}).Value(); err != nil || v != "(10.5,USD)" {
+		t.Errorf("Composite.Value() => (%v, %v), expected (10.5,USD)", v, err)
+	}
+}
+
+func TestCompositeSQLErrors(t *testing.T) {
+	var parseErrors = []string{"10.50,USD", "(10.50)", "(ten,USD)", "(10.50,USD,1)", "("}
+	for _, input := range parseErrors {
+		var c Composite
+		var e *money.ErrParse
+		if err := c.Scan(input); !errors.As(err, &e) {
+			t.Errorf("Composite.Scan(%q) => %v, expected ErrParse", input, err)
+		}
+	}
+
+	var c Composite
+	var unknown *money.ErrUnknownCurrency
+	if err := c.Scan("(10,ZZZ)"); !errors.As(err, &unknown) {
+		t.Errorf("Composite.Scan() => %v, expected ErrUnknownCurrency", err)
+	}
+	var scan *money.ErrScan
+	if err := c.Scan(int64(10)); !errors.As(err, &scan) {
+		t.Errorf("Composite.Scan(10) => %v, expected ErrScan", err)
+	}
+}
+
+func TestCompositePgx(t *testing.T) {
+	tm := typeMap(t)
+	monies := []money.Money{m("10.50", USD), m("-0.333", USD), m("5000", XAF), m("0", USD), m("123456789012345678901234.5", USD)}
+
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		for _, expected := range monies {
+			buf, err := tm.Encode(oid, format, Composite{expected}, nil)
+			if err != nil {
+				t.Errorf("Encode(%s, %d) => unexpected error %s", expected, format, err)
+				continue
+			}
+
+			var c Composite
+			if err := tm.Scan(oid, format, buf, &c); err != nil || !c.Equals(expected) {
+				t.Errorf("Scan(%s, %d) => (%s, %v), expected %s", expected, format, c.Money, err, expected)
+			}
+		}
+	}
+}
+
+func TestCompositePgxErrors(t *testing.T) {
+	tm := typeMap(t)
+
+	var c Composite
+	if err := tm.Scan(oid, pgtype.TextFormatCode, nil, &c); err == nil {
+		t.Errorf("Scan(NULL) => %s, expected error", c.Money)
+	}
+
+	buf, _ := tm.Encode(oid, pgtype.TextFormatCode, pgtype.CompositeFields{"10", "ZZZ"}, nil)
+	var unknown *money.ErrUnknownCurrency
+	if err := tm.Scan(oid, pgtype.TextFormatCode, buf, &c); !errors.As(err, &unknown) {
+		t.Errorf("Scan(%s) => %v, expected ErrUnknownCurrency", buf, err)
+	}
+}
+
+func TestMigrations(t *testing.T) {
+	if !strings.Contains(Up, "CREATE TYPE "+TypeName) || !strings.Contains(Up, "CREATE AGGREGATE sum("+TypeName+")") {
+		t.Errorf("Up => doesn't create %s and its sum", TypeName)
+	}
+	if !strings.Contains(Down, "DROP TYPE IF EXISTS "+TypeName) {
+		t.Errorf("Down => doesn't drop %s", TypeName)
+	}
+}
@@ -0,0 +1,168 @@
+// Package prorate splits amounts over time, e.g. a subscription price over
+// the days or calendar months it covers, or over the old and new plan of a
+// mid-cycle change. Parts always add up to the amount being split.
+package prorate
+
+import (
+	"errors"
+	"time"
+
+	"github.com/FoxComm/money"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrInvalidPeriod is returned for periods which end before they start,
+	// or when every period to split over is empty
+	ErrInvalidPeriod = errors.New("invalid period")
+
+	// ErrOutsidePeriod is returned when a plan changes outside its cycle
+	ErrOutsidePeriod = errors.New("date is outside the period")
+)
+
+// monthsLCM is the least common multiple of the lengths of months, 28 to 31
+// days, so that a day's share of its month is a whole number of 1/monthsLCM
+const monthsLCM = 28 * 29 * 15 * 31
+
+// Period is the calendar days from Start up to, but not including, End.
+// Time of day is ignored.
+type Period struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Days is the number of calendar days in the period
+func (p Period) Days() int64 {
+	y1, m1, d1 := p.Start.Date()
+	y2, m2, d2 := p.End.Date()
+	return int64(date(y2, m2, d2).Sub(date(y1, m1, d1)).Hours() / 24)
+}
+
+// Part is the share of an amount for a period
+type Part struct {
+	Period Period
+	Amount money.Money
+}
+
+// ByDay splits amount over periods in proportion to their days. errors with
+// ErrInvalidPeriod.
+func ByDay(amount money.Money, periods ...Period) ([]Part, error) {
+	weights := make([]decimal.Decimal, len(periods))
+	for i, p := range periods {
+		days := p.Days()
+		if days < 0 {
+			return nil, ErrInvalidPeriod
+		}
+		weights[i] = decimal.New(days, 0)
+	}
+	return split(amount, periods, weights)
+}
+
+// Daily splits amount over period into one part per day. errors with
+// ErrInvalidPeriod for empty periods.
+func Daily(amount money.Money, period Period) ([]Part, error) {
+	days := period.Days()
+	if days <= 0 {
+		return nil, ErrInvalidPeriod
+	}
+
+	periods := make([]Period, days)
+	for i := range periods {
+		periods[i] = Period{period.Start.AddDate(0, 0, i), period.Start.AddDate(0, 0, i+1)}
+	}
+	return ByDay(amount, periods...)
+}
+
+// ByMonth splits amount over period into one part per calendar month, each
+// weighted by how much of its month it covers, e.g. all of February weighs as
+// much as all of March, and Jan 17 to Feb 1 weighs 15/31. Parts are periods
+// of calendar dates at midnight UTC. errors with ErrInvalidPeriod for empty
+// periods.
+func ByMonth(amount money.Money, period Period) ([]Part, error) {
+	if period.Days() <= 0 {
+		return nil, ErrInvalidPeriod
+	}
+
+	var periods []Period
+	var weights []decimal.Decimal
+	last := date(period.End.Date())
+	for start := date(period.Start.Date()); start.Before(last); {
+		y, m, _ := start.Date()
+		month := Period{date(y, m, 1), date(y, m+1, 1)}
+
+		end := month.End
+		if last.Before(end) {
+			end = last
+		}
+		part := Period{start, end}
+
+		periods = append(periods, part)
+		weights = append(weights, decimal.New(part.Days()*(monthsLCM/month.Days()), 0))
+		start = end
+	}
+	return split(amount, periods, weights)
+}
+
+// Change is the proration of a plan change within a billing cycle
+type Change struct {
+	// Used is the old plan's price for the days before the change
+	Used money.Money
+
+	// Credit is the old plan's price for the rest of the cycle, so Used +
+	// Credit is the old price
+	Credit money.Money
+
+	// Charge is the new plan's price for the rest of the cycle
+	Charge money.Money
+
+	// Due is Charge - Credit, negative for downgrades
+	Due money.Money
+}
+
+// PlanChange prorates changing from a plan priced old to one priced new at
+// the given date of cycle, by day. errors with ErrInvalidPeriod,
+// ErrOutsidePeriod, or if the currencies are different.
+func PlanChange(old, new money.Money, cycle Period, at time.Time) (Change, error) {
+	if cycle.Days() <= 0 {
+		return Change{}, ErrInvalidPeriod
+	}
+	used := Period{cycle.Start, at}
+	rest := Period{at, cycle.End}
+	if used.Days() < 0 || rest.Days() < 0 {
+		return Change{}, ErrOutsidePeriod
+	}
+
+	olds, err := ByDay(old, used, rest)
+	if err != nil {
+		return Change{}, err
+	}
+	news, err := ByDay(new, used, rest)
+	if err != nil {
+		return Change{}, err
+	}
+
+	due, err := news[1].Amount.Sub(olds[1].Amount)
+	if err != nil {
+		return Change{}, err
+	}
+	return Change{olds[0].Amount, olds[1].Amount, news[1].Amount, due}, nil
+}
+
+func split(amount money.Money, periods []Period, weights []decimal.Decimal) ([]Part, error) {
+	amounts, err := amount.Allocate(weights...)
+	if err == money.ErrInvalidWeights {
+		return nil, ErrInvalidPeriod
+	} else if err != nil {
+		return nil, err
+	}
+
+	parts := make([]Part, len(periods))
+	for i, p := range periods {
+		parts[i] = Part{p, amounts[i]}
+	}
+	return parts, nil
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,177 @@
+package prorate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/FoxComm/money"
+	. "github.com/FoxComm/money/currency"
+)
+
+func m(amount string) money.Money {
+	return money.MustMakeFromString(amount, USD)
+}
+
+func period(start, end time.Time) Period {
+	return Period{start, end}
+}
+
+func amounts(parts []Part) []string {
+	out := make([]string, len(parts))
+	for i, p := range parts {
+		out[i] = p.Amount.String()
+	}
+	return out
+}
+
+func equal(parts []Part, expected []string) bool {
+	if len(parts) != len(expected) {
+		return false
+	}
+	for i, p := range parts {
+		if !p.Amount.Equals(m(expected[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestDays(t *testing.T) {
+	var periods = []struct {
+		period   Period
+		expected int64
+	}{
+		{period(date(2024, 1, 1), date(2024, 2, 1)), 31},
+		{period(date(2024, 2, 1), date(2024, 3, 1)), 29},
+		{period(date(2024, 1, 1), date(2025, 1, 1)), 366},
+		{period(date(2024, 1, 1).Add(23*time.Hour), date(2024, 1, 2)), 1},
+		{period(date(2024, 1, 2), date(2024, 1, 1)), -1},
+	}
+
+	for _, p := range periods {
+		if actual := p.period.Days(); actual != p.expected {
+			t.Errorf("Period.Days() => %d, expected %d", actual, p.expected)
+		}
+	}
+}
+
+func TestByDay(t *testing.T) {
+	parts, err := ByDay(m("100"), period(date(2024, 1, 1), date(2024, 1, 11)), period(date(2024, 1, 11), date(2024, 2, 1)))
+	if err != nil || !equal(parts, []string{"32.26", "67.74"}) {
+		t.Errorf("ByDay() => (%v, %v), expected [USD 32.26 USD 67.74]", amounts(parts), err)
+	}
+
+	if _, err := ByDay(m("100"), period(date(2024, 1, 2), date(2024, 1, 1))); err != ErrInvalidPeriod {
+		t.Errorf("ByDay() => %v, expected ErrInvalidPeriod", err)
+	}
+	if _, err := ByDay(m("100"), period(date(2024, 1, 1), date(2024, 1, 1))); err != ErrInvalidPeriod {
+		t.Errorf("ByDay() => %v, expected ErrInvalidPeriod", err)
+	}
+}
+
+func TestDaily(t *testing.T) {
+	parts, err := Daily(m("10"), period(date(2024, 1, 1), date(2024, 1, 4)))
+	if err != nil || !equal(parts, []string{"3.34", "3.33", "3.33"}) {
+		t.Errorf("Daily() => (%v, %v), expected [USD 3.34 USD 3.33 USD 3.33]", amounts(parts), err)
+	}
+	if !parts[2].Period.Start.Equal(date(2024, 1, 3)) || !parts[2].Period.End.Equal(date(2024, 1, 4)) {
+		t.Errorf("Daily()[2].Period => %v, expected Jan 3 to Jan 4", parts[2].Period)
+	}
+
+	parts, _ = Daily(m("100"), period(date(2024, 1, 1), date(2024, 2, 1)))
+	sum := m("0")
+	for _, p := range parts {
+		sum = sum.MustAdd(p.Amount)
+	}
+	if len(parts) != 31 || !sum.Equals(m("100")) {
+		t.Errorf("Daily() => %d parts summing to %s, expected 31 summing to USD 100.00", len(parts), sum)
+	}
+
+	if _, err := Daily(m("10"), period(date(2024, 1, 1), date(2024, 1, 1))); err != ErrInvalidPeriod {
+		t.Errorf("Daily() => %v, expected ErrInvalidPeriod", err)
+	}
+}
+
+func TestByMonth(t *testing.T) {
+	var splits = []struct {
+		amount   string
+		period   Period
+		expected []string
+	}{
+		{"100", period(date(2024, 1, 17), date(2024, 3, 1)), []string{"32.61", "67.39"}},
+		{"100", period(date(2024, 2, 1), date(2024, 4, 1)), []string{"50.00", "50.00"}},
+		{"100", period(date(2024, 2, 10), date(2024, 2, 20)), []string{"100.00"}},
+		{"90", period(date(2023, 12, 1), date(2024, 3, 1)), []string{"30.00", "30.00", "30.00"}},
+	}
+
+	for _, s := range splits {
+		parts, err := ByMonth(m(s.amount), s.period)
+		if err != nil || !equal(parts, s.expected) {
+			t.Errorf("ByMonth(%s) => (%v, %v), expected %v", s.amount, amounts(parts), err, s.expected)
+		}
+	}
+
+	parts, _ := ByMonth(m("100"), period(date(2024, 1, 17), date(2024, 3, 1)))
+	if !parts[0].Period.End.Equal(date(2024, 2, 1)) || !parts[1].Period.Start.Equal(date(2024, 2, 1)) {
+		t.Errorf("ByMonth() => periods %v, expected split on Feb 1", parts)
+	}
+
+	// New York dates split the same as UTC ones, and time of day is ignored
+	ny := time.FixedZone("EST", -5*60*60)
+	var periods = []Period{
+		period(time.Date(2024, 1, 17, 0, 0, 0, 0, ny), time.Date(2024, 3, 1, 0, 0, 0, 0, ny)),
+		period(time.Date(2024, 1, 17, 10, 0, 0, 0, time.UTC), time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)),
+		period(time.Date(2024, 1, 17, 23, 0, 0, 0, ny), time.Date(2024, 3, 1, 1, 0, 0, 0, ny)),
+	}
+	for _, p := range periods {
+		parts, err := ByMonth(m("100"), p)
+		if err != nil || !equal(parts, []string{"32.61", "67.39"}) {
+			t.Errorf("ByMonth(%v) => (%v, %v), expected [32.61 67.39]", p, amounts(parts), err)
+		}
+	}
+
+	parts, err := ByMonth(m("100"), period(time.Date(2024, 1, 17, 10, 0, 0, 0, time.UTC), time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC)))
+	if err != nil || !equal(parts, []string{"100.00"}) {
+		t.Errorf("ByMonth() => (%v, %v), expected no empty February part", amounts(parts), err)
+	}
+
+	if _, err := ByMonth(m("10"), period(date(2024, 1, 1), date(2024, 1, 1))); err != ErrInvalidPeriod {
+		t.Errorf("ByMonth() => %v, expected ErrInvalidPeriod", err)
+	}
+}
+
+func TestPlanChange(t *testing.T) {
+	april := period(date(2024, 4, 1), date(2024, 5, 1))
+
+	var changes = []struct {
+		old, new string
+		at       time.Time
+		expected Change
+	}{
+		{"30", "60", date(2024, 4, 11), Change{m("10"), m("20"), m("40"), m("20")}},
+		{"60", "30", date(2024, 4, 11), Change{m("20"), m("40"), m("20"), m("-20")}},
+		{"30", "60", date(2024, 4, 1), Change{m("0"), m("30"), m("60"), m("30")}},
+		{"30", "60", date(2024, 5, 1), Change{m("30"), m("0"), m("0"), m("0")}},
+	}
+
+	for _, c := range changes {
+		actual, err := PlanChange(m(c.old), m(c.new), april, c.at)
+		if err != nil || !actual.Used.Equals(c.expected.Used) || !actual.Credit.Equals(c.expected.Credit) ||
+			!actual.Charge.Equals(c.expected.Charge) || !actual.Due.Equals(c.expected.Due) {
+			t.Errorf("PlanChange(%s, %s, %s) => (%+v, %v), expected %+v", c.old, c.new, c.at, actual, err, c.expected)
+		}
+	}
+
+	if _, err := PlanChange(m("30"), m("60"), april, date(2024, 5, 2)); err != ErrOutsidePeriod {
+		t.Errorf("PlanChange() => %v, expected ErrOutsidePeriod", err)
+	}
+	if _, err := PlanChange(m("30"), m("60"), period(date(2024, 4, 1), date(2024, 4, 1)), date(2024, 4, 1)); err != ErrInvalidPeriod {
+		t.Errorf("PlanChange() => %v, expected ErrInvalidPeriod", err)
+	}
+
+	var e *money.ErrDifferentCurrency
+	if _, err := PlanChange(m("30"), money.MustMakeFromString("60", CAD), april, date(2024, 4, 11)); !errors.As(err, &e) {
+		t.Errorf("PlanChange() => %v, expected ErrDifferentCurrency", err)
+	}
+}
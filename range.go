@@ -0,0 +1,307 @@
+package money
+
+import (
+	"strings"
+
+	"github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+)
+
+// Bounds tells whether each end of a Range includes its amount
+type Bounds int
+
+const (
+	// Closed includes both ends, i.e. [min, max]
+	Closed Bounds = iota
+
+	// ClosedOpen includes min but not max, i.e. [min, max)
+	ClosedOpen
+
+	// OpenClosed includes max but not min, i.e. (min, max]
+	OpenClosed
+
+	// Open excludes both ends, i.e. (min, max)
+	Open
+)
+
+func makeBounds(minOpen, maxOpen bool) Bounds {
+	switch {
+	case minOpen && maxOpen:
+		return Open
+	case minOpen:
+		return OpenClosed
+	case maxOpen:
+		return ClosedOpen
+	}
+	return Closed
+}
+
+func (b Bounds) minOpen() bool {
+	return b == OpenClosed || b == Open
+}
+
+func (b Bounds) maxOpen() bool {
+	return b == ClosedOpen || b == Open
+}
+
+// Range represents an interval of amounts of one currency as an immutable
+// value, e.g. the search facet [USD 10.00, USD 25.00). Ranges are never empty.
+type Range struct {
+	min    Money
+	max    Money
+	bounds Bounds
+}
+
+// MakeRange makes a Range from min to max. errors if the currencies are
+// different or with ErrInvalidBounds if the range would be empty.
+func MakeRange(min, max Money, bounds Bounds) (Range, error) {
+	cmp, err := min.Cmp(max)
+	if err != nil {
+		return Range{}, err
+	}
+	if cmp > 0 || cmp == 0 && bounds != Closed {
+		return Range{}, ErrInvalidBounds
+	}
+	return Range{min, max, bounds}, nil
+}
+
+// Min is the lower end of the range
+func (r Range) Min() Money {
+	return r.min
+}
+
+// Max is the upper end of the range
+func (r Range) Max() Money {
+	return r.max
+}
+
+// Bounds tells whether the ends are included
+func (r Range) Bounds() Bounds {
+	return r.bounds
+}
+
+// Currency returns the set Currency
+func (r Range) Currency() currency.Currency {
+	return r.min.currency
+}
+
+// String represents the range in interval notation, e.g.
+// "[USD 10.00, USD 25.00)"
+func (r Range) String() string {
+	left, right := "[", "]"
+	if r.bounds.minOpen() {
+		left = "("
+	}
+	if r.bounds.maxOpen() {
+		right = ")"
+	}
+	return left + r.min.String() + ", " + r.max.String() + right
+}
+
+// Equals is true if other Range has the same ends and bounds
+func (r Range) Equals(other Range) bool {
+	return r.bounds == other.bounds && r.min.Equals(other.min) && r.max.Equals(other.max)
+}
+
+// Contains is true if m lies within the range. errors if currency is
+// different.
+func (r Range) Contains(m Money) (bool, error) {
+	lower, err := m.Cmp(r.min)
+	if err != nil {
+		return false, err
+	}
+	upper, _ := m.Cmp(r.max)
+
+	if lower < 0 || lower == 0 && r.bounds.minOpen() {
+		return false, nil
+	}
+	return upper < 0 || upper == 0 && !r.bounds.maxOpen(), nil
+}
+
+// Overlaps is true if the ranges share at least one amount. errors if
+// currency is different.
+func (r Range) Overlaps(other Range) (bool, error) {
+	_, ok, err := r.Intersect(other)
+	return ok, err
+}
+
+// Intersect returns the amounts shared by both ranges. ok is false if there
+// are none. errors if currency is different.
+func (r Range) Intersect(other Range) (intersection Range, ok bool, err error) {
+	lower, err := r.min.Cmp(other.min)
+	if err != nil {
+		return Range{}, false, err
+	}
+	upper, _ := r.max.Cmp(other.max)
+
+	min, minOpen := r.min, r.bounds.minOpen()
+	if lower < 0 {
+		min, minOpen = other.min, other.bounds.minOpen()
+	} else if lower == 0 {
+		minOpen = minOpen || other.bounds.minOpen()
+	}
+
+	max, maxOpen := r.max, r.bounds.maxOpen()
+	if upper > 0 {
+		max, maxOpen = other.max, other.bounds.maxOpen()
+	} else if upper == 0 {
+		maxOpen = maxOpen || other.bounds.maxOpen()
+	}
+
+	intersection, err = MakeRange(min, max, makeBounds(minOpen, maxOpen))
+	return intersection, err == nil, nil
+}
+
+// Union joins ranges which overlap or touch, e.g. [1, 2) and [2, 3] make
+// [1, 3]. errors if currency is different or with ErrDisjointRanges.
+func (r Range) Union(other Range) (Range, error) {
+	overlaps, err := r.Overlaps(other)
+	if err != nil {
+		return Range{}, err
+	}
+	if !overlaps && !r.touches(other) && !other.touches(r) {
+		return Range{}, ErrDisjointRanges
+	}
+
+	lower, _ := r.min.Cmp(other.min)
+	upper, _ := r.max.Cmp(other.max)
+
+	min, minOpen := r.min, r.bounds.minOpen()
+	if lower > 0 {
+		min, minOpen = other.min, other.bounds.minOpen()
+	} else if lower == 0 {
+		minOpen = minOpen && other.bounds.minOpen()
+	}
+
+	max, maxOpen := r.max, r.bounds.maxOpen()
+	if upper < 0 {
+		max, maxOpen = other.max, other.bounds.maxOpen()
+	} else if upper == 0 {
+		maxOpen = maxOpen && other.bounds.maxOpen()
+	}
+
+	return MakeRange(min, max, makeBounds(minOpen, maxOpen))
+}
+
+// touches is true if r ends where other starts without a gap
+func (r Range) touches(other Range) bool {
+	return r.max.Equals(other.min) && !(r.bounds.maxOpen() && other.bounds.minOpen())
+}
+
+// SplitAt splits the range into consecutive buckets at the given amounts,
+// e.g. [0, 100] at 10 and 25 gives [0, 10), [10, 25) and [25, 100]. errors
+// if currency is different or with ErrInvalidBounds if the points aren't
+// increasing and strictly within the range.
+func (r Range) SplitAt(points ...Money) ([]Range, error) {
+	buckets := make([]Range, 0, len(points)+1)
+	min, minOpen := r.min, r.bounds.minOpen()
+
+	for _, point := range points {
+		if cmp, err := point.Cmp(min); err != nil {
+			return nil, err
+		} else if cmp <= 0 {
+			return nil, ErrInvalidBounds
+		}
+		if cmp, _ := point.Cmp(r.max); cmp >= 0 {
+			return nil, ErrInvalidBounds
+		}
+
+		bucket, err := MakeRange(min, point, makeBounds(minOpen, true))
+		if err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, bucket)
+		min, minOpen = point, false
+	}
+
+	last, err := MakeRange(min, r.max, makeBounds(minOpen, r.bounds.maxOpen()))
+	if err != nil {
+		return nil, err
+	}
+	return append(buckets, last), nil
+}
+
+// Split splits the range into n buckets of equal width, rounded to the
+// currency's minor unit. errors with ErrInvalidBounds if n < 1 or the range
+// is too narrow for n buckets.
+func (r Range) Split(n int) ([]Range, error) {
+	if n < 1 {
+		return nil, ErrInvalidBounds
+	}
+
+	c := r.Currency()
+	span := r.max.amount.Sub(r.min.amount)
+	points := make([]Money, 0, n-1)
+
+	for i := 1; i < n; i++ {
+		offset := span.Mul(decimal.New(int64(i), 0))
+		divisor := decimal.New(int64(n), 0)
+
+		if c.HasMinorUnits() {
+			var err error
+			if offset, err = RoundHalfUp.Quo(offset, divisor, c.Digits()); err != nil {
+				return nil, err
+			}
+		} else {
+			offset = offset.Div(divisor)
+		}
+		points = append(points, Make(r.min.amount.Add(offset), c))
+	}
+
+	return r.SplitAt(points...)
+}
+
+// ParseRange parses a Range.String() into a Range
+func ParseRange(str string) (Range, error) {
+	if len(str) < 2 {
+		return Range{}, &ErrParse{Input: str}
+	}
+
+	first, last := str[0], str[len(str)-1]
+	if first != '[' && first != '(' || last != ']' && last != ')' {
+		return Range{}, &ErrParse{Input: str}
+	}
+
+	ends := strings.SplitN(str[1:len(str)-1], ",", 2)
+	if len(ends) != 2 {
+		return Range{}, &ErrParse{Input: str}
+	}
+
+	min, err := Parse(strings.TrimSpace(ends[0]))
+	if err != nil {
+		return Range{}, err
+	}
+	max, err := Parse(strings.TrimSpace(ends[1]))
+	if err != nil {
+		return Range{}, err
+	}
+
+	return MakeRange(min, max, makeBounds(first == '(', last == ')'))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *Range) UnmarshalJSON(data []byte) (err error) {
+	if isNull(data) {
+		return nil
+	}
+	*r, err = ParseRange(strings.Trim(string(data), `"`))
+	return
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r Range) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + r.String() + `"`), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for XML
+// deserialization.
+func (r *Range) UnmarshalText(text []byte) (err error) {
+	*r, err = ParseRange(string(text))
+	return
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for XML
+// serialization.
+func (r Range) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
@@ -0,0 +1,163 @@
+package money
+
+import (
+	"testing"
+
+	. "github.com/FoxComm/money/currency"
+)
+
+func mustRange(str string) Range {
+	rng, err := ParseRange(str)
+	if err != nil {
+		panic(err)
+	}
+	return rng
+}
+
+func TestMakeRange(t *testing.T) {
+	ten, twenty := Make(d("10"), USD), Make(d("20"), USD)
+
+	var ranges = []struct {
+		min, max Money
+		bounds   Bounds
+		err      error
+	}{
+		{ten, twenty, Closed, nil},
+		{ten, twenty, Open, nil},
+		{ten, ten, Closed, nil},
+		{ten, ten, ClosedOpen, ErrInvalidBounds},
+		{twenty, ten, Closed, ErrInvalidBounds},
+	}
+
+	for _, rng := range ranges {
+		if _, err := MakeRange(rng.min, rng.max, rng.bounds); err != rng.err {
+			t.Errorf("MakeRange(%s, %s, %d) => %v, expected %v", rng.min, rng.max, rng.bounds, err, rng.err)
+		}
+	}
+
+	_, err := MakeRange(ten, Make(d("20"), MXN), Closed)
+	checkForZeroAndErr(t, "MakeRange()", Zero(USD), err)
+}
+
+func TestRangeContains(t *testing.T) {
+	var ranges = []struct {
+		rng      string
+		money    Money
+		expected bool
+	}{
+		{"[USD 10.00, USD 25.00)", Make(d("10"), USD), true},
+		{"[USD 10.00, USD 25.00)", Make(d("25"), USD), false},
+		{"[USD 10.00, USD 25.00)", Make(d("24.99"), USD), true},
+		{"(USD 10.00, USD 25.00]", Make(d("10"), USD), false},
+		{"(USD 10.00, USD 25.00]", Make(d("25"), USD), true},
+		{"[USD 10.00, USD 25.00]", Make(d("9.99"), USD), false},
+		{"[USD 10.00, USD 10.00]", Make(d("10"), USD), true},
+	}
+
+	for _, rng := range ranges {
+		if contains, err := mustRange(rng.rng).Contains(rng.money); err != nil || contains != rng.expected {
+			t.Errorf("%s.Contains(%s) => (%t, %v), expected %t", rng.rng, rng.money, contains, err, rng.expected)
+		}
+	}
+
+	if _, err := mustRange("[USD 10.00, USD 25.00]").Contains(Make(d("1"), MXN)); err == nil {
+		t.Errorf("Range.Contains() => expected ErrDifferentCurrency")
+	}
+}
+
+func TestRangeIntersectAndUnion(t *testing.T) {
+	var ranges = []struct {
+		a, b         string
+		intersection string
+		union        string
+	}{
+		{"[USD 10.00, USD 25.00)", "[USD 20.00, USD 30.00]", "[USD 20.00, USD 25.00)", "[USD 10.00, USD 30.00]"},
+		{"[USD 10.00, USD 25.00]", "(USD 10.00, USD 25.00)", "(USD 10.00, USD 25.00)", "[USD 10.00, USD 25.00]"},
+		{"[USD 10.00, USD 20.00)", "[USD 20.00, USD 30.00]", "", "[USD 10.00, USD 30.00]"},
+		{"[USD 10.00, USD 20.00]", "[USD 20.00, USD 30.00]", "[USD 20.00, USD 20.00]", "[USD 10.00, USD 30.00]"},
+		{"[USD 10.00, USD 20.00)", "(USD 20.00, USD 30.00]", "", ""},
+		{"[USD 10.00, USD 15.00]", "[USD 20.00, USD 30.00]", "", ""},
+	}
+
+	for _, rng := range ranges {
+		a, b := mustRange(rng.a), mustRange(rng.b)
+
+		intersection, ok, err := a.Intersect(b)
+		if err != nil || ok != (rng.intersection != "") || ok && !intersection.Equals(mustRange(rng.intersection)) {
+			t.Errorf("%s.Intersect(%s) => (%s, %t, %v), expected %q", a, b, intersection, ok, err, rng.intersection)
+		}
+		if overlaps, _ := b.Overlaps(a); overlaps != ok {
+			t.Errorf("%s.Overlaps(%s) => %t, expected %t", b, a, overlaps, ok)
+		}
+
+		union, err := b.Union(a)
+		if rng.union == "" && err != ErrDisjointRanges {
+			t.Errorf("%s.Union(%s) => %v, expected ErrDisjointRanges", b, a, err)
+		} else if rng.union != "" && (err != nil || !union.Equals(mustRange(rng.union))) {
+			t.Errorf("%s.Union(%s) => (%s, %v), expected %s", b, a, union, err, rng.union)
+		}
+	}
+}
+
+func TestRangeSplit(t *testing.T) {
+	buckets, err := mustRange("[USD 0.00, USD 100.00]").SplitAt(Make(d("10"), USD), Make(d("25"), USD))
+	expected := []string{"[USD 0.00, USD 10.00)", "[USD 10.00, USD 25.00)", "[USD 25.00, USD 100.00]"}
+	if err != nil || len(buckets) != len(expected) {
+		t.Fatalf("Range.SplitAt() => (%v, %v), expected %v", buckets, err, expected)
+	}
+	for i, bucket := range buckets {
+		if bucket.String() != expected[i] {
+			t.Errorf("Range.SplitAt()[%d] => %s, expected %s", i, bucket, expected[i])
+		}
+	}
+
+	buckets, err = mustRange("(USD 0.00, USD 10.00)").Split(3)
+	expected = []string{"(USD 0.00, USD 3.33)", "[USD 3.33, USD 6.67)", "[USD 6.67, USD 10.00)"}
+	if err != nil || len(buckets) != len(expected) {
+		t.Fatalf("Range.Split(3) => (%v, %v), expected %v", buckets, err, expected)
+	}
+	for i, bucket := range buckets {
+		if bucket.String() != expected[i] {
+			t.Errorf("Range.Split(3)[%d] => %s, expected %s", i, bucket, expected[i])
+		}
+	}
+
+	if _, err := mustRange("[USD 0.00, USD 100.00]").SplitAt(Make(d("25"), USD), Make(d("10"), USD)); err != ErrInvalidBounds {
+		t.Errorf("Range.SplitAt() => %v, expected ErrInvalidBounds", err)
+	}
+	if _, err := mustRange("[USD 0.00, USD 100.00]").SplitAt(Make(d("100"), USD)); err != ErrInvalidBounds {
+		t.Errorf("Range.SplitAt() => %v, expected ErrInvalidBounds", err)
+	}
+	if _, err := mustRange("[USD 0.00, USD 0.02]").Split(3); err != ErrInvalidBounds {
+		t.Errorf("Range.Split(3) => %v, expected ErrInvalidBounds", err)
+	}
+	if _, err := mustRange("[USD 0.00, USD 0.02]").Split(0); err != ErrInvalidBounds {
+		t.Errorf("Range.Split(0) => %v, expected ErrInvalidBounds", err)
+	}
+}
+
+func TestRangeSerialization(t *testing.T) {
+	rng := mustRange("[USD 10.00, USD 25.00)")
+
+	byt, err := rng.MarshalJSON()
+	if expected := `"[USD 10.00, USD 25.00)"`; err != nil || string(byt) != expected {
+		t.Errorf("Range.MarshalJSON() => (%s, %v), expected %s", byt, err, expected)
+	}
+
+	var parsed Range
+	if err := parsed.UnmarshalJSON(byt); err != nil || !parsed.Equals(rng) {
+		t.Errorf("Range.UnmarshalJSON() => (%s, %v), expected %s", parsed, err, rng)
+	}
+
+	byt, _ = rng.MarshalText()
+	parsed = Range{}
+	if err := parsed.UnmarshalText(byt); err != nil || !parsed.Equals(rng) {
+		t.Errorf("Range.UnmarshalText() => (%s, %v), expected %s", parsed, err, rng)
+	}
+
+	for _, str := range []string{"", "USD 10.00, USD 25.00", "[USD 10.00]", "{USD 10.00, USD 25.00}"} {
+		if _, err := ParseRange(str); err == nil {
+			t.Errorf("ParseRange(%q) => expected error", str)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package money
+
+import (
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// Rounding decides how amounts are rounded to a currency's minor unit
+type Rounding int
+
+const (
+	// RoundHalfUp rounds half away from zero, e.g. 0.125 => 0.13
+	RoundHalfUp Rounding = iota
+
+	// RoundHalfEven rounds half to the nearest even digit (banker's rounding),
+	// e.g. 0.125 => 0.12
+	RoundHalfEven
+
+	// RoundDown truncates towards zero, e.g. -0.129 => -0.12
+	RoundDown
+
+	// RoundFloor rounds towards negative infinity, e.g. -0.121 => -0.13
+	RoundFloor
+
+	// RoundCeiling rounds towards positive infinity, e.g. 0.121 => 0.13
+	RoundCeiling
+)
+
+// Round rounds d to the given number of decimal places
+func (r Rounding) Round(d decimal.Decimal, places int32) decimal.Decimal {
+	switch r {
+	case RoundHalfEven:
+		return d.RoundBank(places)
+	case RoundDown:
+		return d.Truncate(places)
+	case RoundFloor:
+		return d.Shift(places).Floor().Shift(-places)
+	case RoundCeiling:
+		return d.Shift(places).Ceil().Shift(-places)
+	default:
+		return d.Round(places)
+	}
+}
+
+// Quo divides a by b and rounds the exact quotient to the given number of
+// decimal places, avoiding the double rounding of decimal.Div. errors with
+// ErrDivisionByZero if b is zero.
+func (r Rounding) Quo(a, b decimal.Decimal, places int32) (decimal.Decimal, error) {
+	if b.Sign() == 0 {
+		return decimal.Decimal{}, ErrDivisionByZero
+	}
+
+	q := new(big.Rat).Quo(a.Rat(), b.Rat())
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	num := new(big.Int).Mul(q.Num(), scale)
+	den := q.Denom()
+
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return decimal.NewFromBigInt(quo, -places), nil
+	}
+
+	away := false
+	switch r {
+	case RoundDown:
+	case RoundFloor:
+		away = num.Sign() < 0
+	case RoundCeiling:
+		away = num.Sign() > 0
+	default:
+		half := new(big.Int).Lsh(new(big.Int).Abs(rem), 1).Cmp(den)
+		away = half > 0 || half == 0 && (r == RoundHalfUp || quo.Bit(0) == 1)
+	}
+
+	if away {
+		quo.Add(quo, big.NewInt(int64(num.Sign())))
+	}
+	return decimal.NewFromBigInt(quo, -places), nil
+}
+
+// RoundWith rounds the amount to the currency's minor unit using r.
+// Currencies without minor units, such as XAU, are left untouched.
+func (m Money) RoundWith(r Rounding) Money {
+	if !m.currency.HasMinorUnits() {
+		return m
+	}
+	return Make(r.Round(m.amount, m.currency.Digits()), m.currency)
+}
@@ -0,0 +1,66 @@
+package money
+
+import (
+	"testing"
+
+	. "github.com/FoxComm/money/currency"
+)
+
+func TestRounding(t *testing.T) {
+	var amounts = []struct {
+		amount   string
+		rounding Rounding
+		expected string
+	}{
+		{"0.125", RoundHalfUp, "0.13"},
+		{"-0.125", RoundHalfUp, "-0.13"},
+		{"0.125", RoundHalfEven, "0.12"},
+		{"0.135", RoundHalfEven, "0.14"},
+		{"-0.129", RoundDown, "-0.12"},
+		{"0.129", RoundDown, "0.12"},
+		{"-0.121", RoundFloor, "-0.13"},
+		{"0.129", RoundFloor, "0.12"},
+		{"0.121", RoundCeiling, "0.13"},
+		{"-0.129", RoundCeiling, "-0.12"},
+	}
+
+	for _, a := range amounts {
+		if actual := a.rounding.Round(d(a.amount), 2); !actual.Equals(d(a.expected)) {
+			t.Errorf("Rounding(%d).Round(%s, 2) => %s, expected %s", a.rounding, a.amount, actual, a.expected)
+		}
+
+		if actual := Make(d(a.amount), USD).RoundWith(a.rounding); !actual.Amount().Equals(d(a.expected)) {
+			t.Errorf("Money.RoundWith(%d) => %s, expected %s", a.rounding, actual.Amount(), a.expected)
+		}
+	}
+}
+
+func TestRoundingQuo(t *testing.T) {
+	var quotients = []struct {
+		a, b     string
+		rounding Rounding
+		expected string
+	}{
+		{"10", "3", RoundHalfUp, "3.33"},
+		{"20", "3", RoundHalfUp, "6.67"},
+		{"-20", "3", RoundHalfUp, "-6.67"},
+		{"0.25", "2", RoundHalfUp, "0.13"},
+		{"0.25", "2", RoundHalfEven, "0.12"},
+		{"-0.25", "2", RoundHalfEven, "-0.12"},
+		{"20", "3", RoundDown, "6.66"},
+		{"-20", "3", RoundFloor, "-6.67"},
+		{"10", "3", RoundCeiling, "3.34"},
+		{"10", "-4", RoundHalfUp, "-2.5"},
+		{"0.0049999999999999999999", "1", RoundHalfUp, "0"},
+	}
+
+	for _, q := range quotients {
+		if actual, err := q.rounding.Quo(d(q.a), d(q.b), 2); err != nil || !actual.Equals(d(q.expected)) {
+			t.Errorf("Rounding(%d).Quo(%s, %s, 2) => (%s, %v), expected %s", q.rounding, q.a, q.b, actual, err, q.expected)
+		}
+	}
+
+	if _, err := RoundHalfUp.Quo(d("1"), d("0"), 2); err != ErrDivisionByZero {
+		t.Errorf("Rounding.Quo(1, 0, 2) => %v, expected ErrDivisionByZero", err)
+	}
+}
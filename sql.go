@@ -0,0 +1,185 @@
+package money
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"math"
+	"strings"
+
+	"github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+)
+
+// Columns maps Money onto two columns, an amount and a currency code, so
+// amounts can be summed, indexed and range queried in SQL:
+//
+//	cols := money.NumericColumns(&price)
+//	db.Exec("INSERT INTO items (price, currency) VALUES ($1, $2)", cols.Args()...)
+//	row.Scan(cols.Dest()...)
+//
+// Columns is not safe for concurrent use.
+type Columns struct {
+	money *Money
+	minor bool
+
+	amount    decimal.Decimal
+	hasAmount bool
+	code      string
+}
+
+// NumericColumns maps m to a NUMERIC amount in the major unit, e.g. 10.50,
+// and a currency code column
+func NumericColumns(m *Money) *Columns {
+	return &Columns{money: m}
+}
+
+// MinorColumns maps m to a BIGINT amount in the minor unit, e.g. 1050, and a
+// currency code column. Amounts must be whole minor units and fit an int64.
+func MinorColumns(m *Money) *Columns {
+	return &Columns{money: m, minor: true}
+}
+
+// Args are the amount and currency code as query arguments, in that order.
+// Errors, such as ErrPrecisionLoss for minor units, are returned by the query.
+func (c *Columns) Args() []interface{} {
+	return []interface{}{(*amountColumn)(c), (*currencyColumn)(c)}
+}
+
+// Dest are the amount and currency code as destinations for Rows.Scan, in
+// that order. Money is set once both have been scanned.
+func (c *Columns) Dest() []interface{} {
+	c.hasAmount, c.code = false, ""
+	return []interface{}{(*amountColumn)(c), (*currencyColumn)(c)}
+}
+
+// set sets Money once both columns are scanned. errors if the currency code
+// is unknown, or a minor amount isn't whole.
+func (c *Columns) set() error {
+	if !c.hasAmount || c.code == "" {
+		return nil
+	}
+
+	cur, ok := currency.Table[c.code]
+	if !ok {
+		return &ErrUnknownCurrency{c.code}
+	}
+
+	amount := c.amount
+	if c.minor {
+		if !amount.Equals(amount.Truncate(0)) {
+			return ErrPrecisionLoss
+		}
+		amount = amount.Shift(-cur.Digits())
+	}
+	*c.money = Make(amount, cur)
+	return nil
+}
+
+// amountColumn is the amount column of Columns
+type amountColumn Columns
+
+// Scan implements the sql.Scanner interface for database deserialization.
+func (a *amountColumn) Scan(value interface{}) (err error) {
+	if a.amount, err = scanDecimal(value); err != nil {
+		return err
+	}
+	a.hasAmount = true
+	return (*Columns)(a).set()
+}
+
+// Value implements the driver.Valuer interface for database serialization.
+func (a *amountColumn) Value() (driver.Value, error) {
+	if !a.minor {
+		return a.money.amount.String(), nil
+	}
+	c, err := a.money.ToCompact()
+	if err != nil {
+		return nil, err
+	}
+	return c.Minor(), nil
+}
+
+// currencyColumn is the currency code column of Columns
+type currencyColumn Columns
+
+// Scan implements the sql.Scanner interface for database deserialization.
+func (c *currencyColumn) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		c.code = strings.TrimSpace(string(v))
+	case string:
+		c.code = strings.TrimSpace(v)
+	default:
+		return &ErrScan{value}
+	}
+	return (*Columns)(c).set()
+}
+
+// Value implements the driver.Valuer interface for database serialization.
+func (c *currencyColumn) Value() (driver.Value, error) {
+	return c.money.currency.Code, nil
+}
+
+// ScanIn scans a column holding amounts of currency c into m. Bare numbers,
+// whether int64, float64 or numeric text, are in the major unit, e.g. 10.50;
+// use MinorColumns for minor units. Text with a currency code is parsed as by
+// Parse and errors if the currency isn't c.
+//
+//	row.Scan(money.ScanIn(&price, currency.USD))
+func ScanIn(m *Money, c currency.Currency) sql.Scanner {
+	return &scanIn{m, c}
+}
+
+type scanIn struct {
+	money    *Money
+	currency currency.Currency
+}
+
+// Scan implements the sql.Scanner interface for database deserialization.
+func (s *scanIn) Scan(value interface{}) error {
+	amount, err := scanDecimal(value)
+	if err == nil {
+		*s.money = Make(amount, s.currency)
+		return nil
+	}
+	if _, ok := err.(*ErrParse); !ok {
+		return err
+	}
+
+	var m Money
+	if err := m.Scan(value); err != nil {
+		return err
+	}
+	if !m.currency.Equals(s.currency) {
+		return &ErrDifferentCurrency{m.currency, s.currency}
+	}
+	*s.money = m
+	return nil
+}
+
+// scanDecimal converts a numeric driver value to a decimal. NUMERIC columns
+// usually arrive as text, BIGINT as int64 and floating point as float64.
+func scanDecimal(value interface{}) (decimal.Decimal, error) {
+	switch v := value.(type) {
+	case []byte:
+		return parseDecimal(strings.TrimSpace(string(v)))
+	case string:
+		return parseDecimal(strings.TrimSpace(v))
+	case int64:
+		return decimal.New(v, 0), nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return decimal.Decimal{}, &ErrScan{value}
+		}
+		return decimal.NewFromFloat(v), nil
+	}
+	return decimal.Decimal{}, &ErrScan{value}
+}
+
+func parseDecimal(str string) (decimal.Decimal, error) {
+	d, err := decimal.NewFromString(str)
+	if err != nil {
+		return d, &ErrParse{str, err}
+	}
+	return d, nil
+}
@@ -0,0 +1,172 @@
+package money
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/FoxComm/money/currency"
+)
+
+func scanColumns(cols *Columns, amount, code interface{}) error {
+	dest := cols.Dest()
+	if err := dest[0].(sql.Scanner).Scan(amount); err != nil {
+		return err
+	}
+	return dest[1].(sql.Scanner).Scan(code)
+}
+
+func values(cols *Columns) (amount, code driver.Value, err error) {
+	args := cols.Args()
+	if amount, err = args[0].(driver.Valuer).Value(); err != nil {
+		return
+	}
+	code, err = args[1].(driver.Valuer).Value()
+	return
+}
+
+func TestScanDriverValues(t *testing.T) {
+	var scans = []interface{}{[]byte("USD 10.00"), "USD 10.00"}
+	for _, value := range scans {
+		var m Money
+		if err := m.Scan(value); err != nil || !m.Equals(Make(d("10"), USD)) {
+			t.Errorf("Money.Scan(%v) => (%s, %v), expected USD 10.00", value, m, err)
+		}
+	}
+
+	// bare numbers don't take the currency left over from an earlier scan
+	m := MustMakeFromString("5", CAD)
+	var e *ErrScan
+	for _, value := range []interface{}{int64(10), float64(10.25), true} {
+		if err := m.Scan(value); !errors.As(err, &e) {
+			t.Errorf("Money.Scan(%v) => %v, expected ErrScan", value, err)
+		}
+	}
+	if err := m.Scan("7"); err == nil {
+		t.Errorf("Money.Scan(7) => %s, expected an error", m)
+	}
+
+	var c Compact
+	if err := c.Scan("USD 10.50"); err != nil || c.Minor() != 1050 {
+		t.Errorf("Compact.Scan(USD 10.50) => (%s, %v), expected USD 10.50", c, err)
+	}
+	if err := c.Scan(int64(1050)); !errors.As(err, &e) {
+		t.Errorf("Compact.Scan(1050) => %v, expected ErrScan", err)
+	}
+}
+
+func TestScanIn(t *testing.T) {
+	var scans = []struct {
+		value    interface{}
+		c        Currency
+		expected Money
+	}{
+		{int64(10), USD, Make(d("10"), USD)},
+		{float64(10.5), USD, Make(d("10.5"), USD)},
+		{[]byte("10.50"), USD, Make(d("10.5"), USD)},
+		{" 1050 ", XAF, Make(d("1050"), XAF)},
+		{"USD 10.00", USD, Make(d("10"), USD)},
+	}
+
+	for _, s := range scans {
+		m := MustMakeFromString("5", CAD)
+		if err := ScanIn(&m, s.c).Scan(s.value); err != nil || !m.Equals(s.expected) {
+			t.Errorf("ScanIn(%s).Scan(%v) => (%s, %v), expected %s", s.c.Code, s.value, m, err, s.expected)
+		}
+	}
+
+	var m Money
+	var different *ErrDifferentCurrency
+	if err := ScanIn(&m, CAD).Scan("USD 10.00"); !errors.As(err, &different) {
+		t.Errorf("ScanIn(CAD).Scan(USD 10.00) => %v, expected ErrDifferentCurrency", err)
+	}
+	var parse *ErrParse
+	if err := ScanIn(&m, USD).Scan("ten"); !errors.As(err, &parse) {
+		t.Errorf("ScanIn(USD).Scan(ten) => %v, expected ErrParse", err)
+	}
+	var scan *ErrScan
+	if err := ScanIn(&m, USD).Scan(math.NaN()); !errors.As(err, &scan) {
+		t.Errorf("ScanIn(USD).Scan(NaN) => %v, expected ErrScan", err)
+	}
+}
+
+func TestNumericColumns(t *testing.T) {
+	var scans = []struct {
+		amount, code interface{}
+		expected     Money
+	}{
+		{[]byte("10.50"), []byte("USD"), Make(d("10.5"), USD)},
+		{"0.333", "USD", Make(d("0.333"), USD)},
+		{int64(5000), "XAF", Make(d("5000"), XAF)},
+		{float64(1.5), "XAU", Make(d("1.5"), XAU)},
+		{"10.50", "USD ", Make(d("10.5"), USD)},
+	}
+
+	for _, s := range scans {
+		var m Money
+		if err := scanColumns(NumericColumns(&m), s.amount, s.code); err != nil || !m.Equals(s.expected) {
+			t.Errorf("NumericColumns.Scan(%v, %v) => (%s, %v), expected %s", s.amount, s.code, m, err, s.expected)
+		}
+	}
+
+	m := Make(d("10.5"), USD)
+	amount, code, err := values(NumericColumns(&m))
+	if err != nil || amount != "10.5" || code != "USD" {
+		t.Errorf("NumericColumns.Args() => (%v, %v, %v), expected (10.5, USD)", amount, code, err)
+	}
+}
+
+func TestMinorColumns(t *testing.T) {
+	var scans = []struct {
+		amount, code interface{}
+		expected     Money
+		minor        int64
+	}{
+		{int64(1050), "USD", Make(d("10.5"), USD), 1050},
+		{[]byte("-5"), "USD", Make(d("-0.05"), USD), -5},
+		{int64(5000), "XAF", Make(d("5000"), XAF), 5000},
+	}
+
+	for _, s := range scans {
+		var m Money
+		if err := scanColumns(MinorColumns(&m), s.amount, s.code); err != nil || !m.Equals(s.expected) {
+			t.Errorf("MinorColumns.Scan(%v, %v) => (%s, %v), expected %s", s.amount, s.code, m, err, s.expected)
+		}
+
+		amount, code, err := values(MinorColumns(&m))
+		if err != nil || amount != s.minor || code != s.code {
+			t.Errorf("MinorColumns.Args() => (%v, %v, %v), expected (%d, %v)", amount, code, err, s.minor, s.code)
+		}
+	}
+
+	m := Make(d("10.505"), USD)
+	if _, _, err := values(MinorColumns(&m)); err != ErrPrecisionLoss {
+		t.Errorf("MinorColumns.Args() => %v, expected ErrPrecisionLoss", err)
+	}
+	if err := scanColumns(MinorColumns(&m), "10.5", "USD"); err != ErrPrecisionLoss {
+		t.Errorf("MinorColumns.Scan(10.5) => %v, expected ErrPrecisionLoss", err)
+	}
+}
+
+func TestColumnsErrors(t *testing.T) {
+	var m Money
+	var unknown *ErrUnknownCurrency
+	if err := scanColumns(NumericColumns(&m), "10", "ZZZ"); !errors.As(err, &unknown) {
+		t.Errorf("NumericColumns.Scan() => %v, expected ErrUnknownCurrency", err)
+	}
+
+	var scan *ErrScan
+	if err := scanColumns(NumericColumns(&m), nil, "USD"); !errors.As(err, &scan) {
+		t.Errorf("NumericColumns.Scan(nil) => %v, expected ErrScan", err)
+	}
+	if err := scanColumns(NumericColumns(&m), "10", int64(840)); !errors.As(err, &scan) {
+		t.Errorf("NumericColumns.Scan(840) => %v, expected ErrScan", err)
+	}
+
+	var parse *ErrParse
+	if err := scanColumns(NumericColumns(&m), "ten", "USD"); !errors.As(err, &parse) {
+		t.Errorf("NumericColumns.Scan(ten) => %v, expected ErrParse", err)
+	}
+}
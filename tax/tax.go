@@ -0,0 +1,189 @@
+// Package tax calculates sales taxes such as US sales tax, VAT, GST/HST and
+// PST on money.Money, for prices with or without tax included.
+package tax
+
+import (
+	"errors"
+
+	"github.com/FoxComm/money"
+	"github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+)
+
+// ErrNoLines is returned when calculating tax for no lines at all
+var ErrNoLines = errors.New("no lines to calculate tax for")
+
+// ErrInvalidRates is returned when backing tax out of gross prices whose
+// rates add up to -100% or less, so no net price has that gross
+var ErrInvalidRates = errors.New("tax rates can't be backed out of gross prices")
+
+// Rate is a named tax rate, e.g. GST at 5%
+type Rate struct {
+	Name    string
+	Percent money.Percent
+
+	// Compound rates are charged on the net plus all preceding rates, e.g.
+	// Quebec's QST before 2013. Others are charged on the net only.
+	Compound bool
+}
+
+// Mode tells whether prices include tax
+type Mode int
+
+const (
+	// Exclusive prices are net, tax is added on top
+	Exclusive Mode = iota
+
+	// Inclusive prices are gross, tax is backed out of them
+	Inclusive
+)
+
+// Strategy tells when tax is rounded to the currency's minor unit
+type Strategy int
+
+const (
+	// PerLine rounds the tax of every line; totals are sums of rounded lines
+	PerLine Strategy = iota
+
+	// PerTotal rounds the tax of the invoice total only; lines are left
+	// unrounded
+	PerTotal
+)
+
+// Calculator calculates tax for lines of one currency
+type Calculator struct {
+	// Rates are applied in order, which matters for compound rates
+	Rates    []Rate
+	Mode     Mode
+	Strategy Strategy
+	Rounding money.Rounding
+}
+
+// Line is the tax breakdown of one line or the total
+type Line struct {
+	Net   money.Money
+	Tax   money.Money
+	Gross money.Money
+
+	// Taxes holds the tax per rate, in the order of Calculator.Rates
+	Taxes []money.Money
+}
+
+// Result is the tax breakdown of the total and every line
+type Result struct {
+	Line
+	Lines []Line
+}
+
+// Calculate calculates tax for lines, which are net or gross prices depending
+// on Mode. errors if the currencies are different, with ErrNoLines or with
+// ErrInvalidRates.
+func (c Calculator) Calculate(lines ...money.Money) (Result, error) {
+	if len(lines) == 0 {
+		return Result{}, ErrNoLines
+	}
+
+	cur := lines[0].Currency()
+	coefficients, factor := c.coefficients()
+	if c.Mode == Inclusive && !factor.IsPositive() {
+		return Result{}, ErrInvalidRates
+	}
+
+	perLine := c.Strategy == PerLine
+	result := Result{Lines: make([]Line, 0, len(lines))}
+	total := money.Zero(cur)
+
+	for _, amount := range lines {
+		var err error
+		if total, err = total.Add(amount); err != nil {
+			return Result{}, err
+		}
+		result.Lines = append(result.Lines, c.line(amount, coefficients, factor, perLine))
+	}
+
+	if perLine {
+		result.Line = sum(cur, len(c.Rates), result.Lines)
+	} else {
+		result.Line = c.line(total, coefficients, factor, true)
+	}
+	return result, nil
+}
+
+// coefficients are the tax per rate on a net of 1, and factor is the gross
+// on a net of 1
+func (c Calculator) coefficients() ([]decimal.Decimal, decimal.Decimal) {
+	one := decimal.New(1, 0)
+	factor := one
+	coefficients := make([]decimal.Decimal, len(c.Rates))
+
+	for i, rate := range c.Rates {
+		base := one
+		if rate.Compound {
+			base = factor
+		}
+		coefficients[i] = base.Mul(rate.Percent.Rate())
+		factor = factor.Add(coefficients[i])
+	}
+	return coefficients, factor
+}
+
+func (c Calculator) line(amount money.Money, coefficients []decimal.Decimal, factor decimal.Decimal, round bool) Line {
+	cur := amount.Currency()
+	taxes := make([]money.Money, len(coefficients))
+	tax := decimal.New(0, 0)
+
+	for i, coefficient := range coefficients {
+		var t decimal.Decimal
+		if c.Mode == Inclusive {
+			t = c.quo(amount.Amount().Mul(coefficient), factor, cur, round)
+		} else {
+			t = amount.Amount().Mul(coefficient)
+			if round {
+				t = money.Make(t, cur).RoundWith(c.Rounding).Amount()
+			}
+		}
+		taxes[i] = money.Make(t, cur)
+		tax = tax.Add(t)
+	}
+
+	line := Line{Tax: money.Make(tax, cur), Taxes: taxes}
+	if c.Mode == Inclusive {
+		line.Gross = amount
+		line.Net = money.Make(amount.Amount().Sub(tax), cur)
+	} else {
+		line.Net = amount
+		line.Gross = money.Make(amount.Amount().Add(tax), cur)
+	}
+	return line
+}
+
+// quo divides exactly, rounding to the currency's minor unit if round is set
+func (c Calculator) quo(a, b decimal.Decimal, cur currency.Currency, round bool) decimal.Decimal {
+	if !round || !cur.HasMinorUnits() {
+		return a.Div(b)
+	}
+	quo, _ := c.Rounding.Quo(a, b, cur.Digits())
+	return quo
+}
+
+func sum(cur currency.Currency, rates int, lines []Line) Line {
+	total := Line{
+		Net:   money.Zero(cur),
+		Tax:   money.Zero(cur),
+		Gross: money.Zero(cur),
+		Taxes: make([]money.Money, rates),
+	}
+	for i := range total.Taxes {
+		total.Taxes[i] = money.Zero(cur)
+	}
+
+	for _, line := range lines {
+		total.Net = total.Net.MustAdd(line.Net)
+		total.Tax = total.Tax.MustAdd(line.Tax)
+		total.Gross = total.Gross.MustAdd(line.Gross)
+		for i, t := range line.Taxes {
+			total.Taxes[i] = total.Taxes[i].MustAdd(t)
+		}
+	}
+	return total
+}
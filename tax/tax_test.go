@@ -0,0 +1,137 @@
+package tax
+
+import (
+	"testing"
+
+	"github.com/FoxComm/money"
+	. "github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	gst = Rate{Name: "GST", Percent: percent("5%")}
+	pst = Rate{Name: "PST", Percent: percent("7%")}
+	hst = Rate{Name: "HST", Percent: percent("13%")}
+	qst = Rate{Name: "QST", Percent: percent("8.5%"), Compound: true}
+)
+
+func percent(str string) money.Percent {
+	p, err := money.ParsePercent(str)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func m(amount string, c Currency) money.Money {
+	return money.MustMakeFromString(amount, c)
+}
+
+func checkLine(t *testing.T, name string, line Line, net, tax, gross string, taxes ...string) {
+	c := line.Net.Currency()
+	if !line.Net.Equals(m(net, c)) || !line.Tax.Equals(m(tax, c)) || !line.Gross.Equals(m(gross, c)) {
+		t.Errorf("%s => net %s, tax %s, gross %s, expected %s, %s, %s", name, line.Net, line.Tax, line.Gross, net, tax, gross)
+	}
+	if len(line.Taxes) != len(taxes) {
+		t.Fatalf("%s => %d taxes, expected %d", name, len(line.Taxes), len(taxes))
+	}
+	for i, tx := range taxes {
+		if !line.Taxes[i].Equals(m(tx, c)) {
+			t.Errorf("%s => tax %d is %s, expected %s", name, i, line.Taxes[i], tx)
+		}
+	}
+}
+
+func TestExclusive(t *testing.T) {
+	calc := Calculator{Rates: []Rate{gst, pst}}
+
+	result, err := calc.Calculate(m("9.99", CAD), m("0.10", CAD))
+	if err != nil {
+		t.Fatalf("Calculate() => unexpected error %s", err)
+	}
+	checkLine(t, "total", result.Line, "10.09", "1.22", "11.31", "0.51", "0.71")
+	checkLine(t, "line 0", result.Lines[0], "9.99", "1.20", "11.19", "0.50", "0.70")
+	checkLine(t, "line 1", result.Lines[1], "0.10", "0.02", "0.12", "0.01", "0.01")
+}
+
+func TestExclusivePerTotal(t *testing.T) {
+	calc := Calculator{Rates: []Rate{gst, pst}, Strategy: PerTotal}
+
+	result, err := calc.Calculate(m("9.99", CAD), m("0.10", CAD))
+	if err != nil {
+		t.Fatalf("Calculate() => unexpected error %s", err)
+	}
+	checkLine(t, "total", result.Line, "10.09", "1.21", "11.30", "0.50", "0.71")
+	checkLine(t, "line 1", result.Lines[1], "0.10", "0.012", "0.112", "0.005", "0.007")
+}
+
+func TestInclusive(t *testing.T) {
+	calc := Calculator{Rates: []Rate{hst}, Mode: Inclusive}
+
+	result, err := calc.Calculate(m("11.30", CAD), m("1.00", CAD))
+	if err != nil {
+		t.Fatalf("Calculate() => unexpected error %s", err)
+	}
+	checkLine(t, "total", result.Line, "10.88", "1.42", "12.30", "1.42")
+	checkLine(t, "line 0", result.Lines[0], "10.00", "1.30", "11.30", "1.30")
+	checkLine(t, "line 1", result.Lines[1], "0.88", "0.12", "1.00", "0.12")
+
+	calc.Strategy = PerTotal
+	if result, err = calc.Calculate(m("11.30", CAD), m("1.00", CAD)); err != nil {
+		t.Fatalf("Calculate() => unexpected error %s", err)
+	}
+	checkLine(t, "total", result.Line, "10.88", "1.42", "12.30", "1.42")
+}
+
+func TestCompound(t *testing.T) {
+	calc := Calculator{Rates: []Rate{gst, qst}}
+
+	result, err := calc.Calculate(m("100", CAD))
+	if err != nil {
+		t.Fatalf("Calculate() => unexpected error %s", err)
+	}
+	checkLine(t, "exclusive", result.Line, "100", "13.93", "113.93", "5.00", "8.93")
+
+	calc.Mode = Inclusive
+	if result, err = calc.Calculate(m("113.93", CAD)); err != nil {
+		t.Fatalf("Calculate() => unexpected error %s", err)
+	}
+	checkLine(t, "inclusive", result.Line, "100", "13.93", "113.93", "5.00", "8.93")
+}
+
+func TestRounding(t *testing.T) {
+	calc := Calculator{Rates: []Rate{{Name: "VAT", Percent: percent("5%")}}, Rounding: money.RoundHalfEven}
+
+	result, err := calc.Calculate(m("0.50", USD), m("0.70", USD))
+	if err != nil {
+		t.Fatalf("Calculate() => unexpected error %s", err)
+	}
+	checkLine(t, "total", result.Line, "1.20", "0.06", "1.26", "0.06")
+	checkLine(t, "line 0", result.Lines[0], "0.50", "0.02", "0.52", "0.02")
+	checkLine(t, "line 1", result.Lines[1], "0.70", "0.04", "0.74", "0.04")
+}
+
+func TestCalculateErrors(t *testing.T) {
+	calc := Calculator{Rates: []Rate{gst}}
+
+	if _, err := calc.Calculate(); err != ErrNoLines {
+		t.Errorf("Calculate() => %v, expected ErrNoLines", err)
+	}
+	if _, err := calc.Calculate(m("1", CAD), m("1", USD)); err == nil {
+		t.Errorf("Calculate() => expected ErrDifferentCurrency")
+	}
+
+	result, err := Calculator{}.Calculate(money.Make(decimal.New(5, 0), CAD))
+	if err != nil {
+		t.Fatalf("Calculate() => unexpected error %s", err)
+	}
+	checkLine(t, "no rates", result.Line, "5", "0", "5")
+
+	refund := Rate{Name: "refund", Percent: percent("-100%")}
+	for _, strategy := range []Strategy{PerLine, PerTotal} {
+		calc := Calculator{Rates: []Rate{refund}, Mode: Inclusive, Strategy: strategy}
+		if _, err := calc.Calculate(m("1", CAD)); err != ErrInvalidRates {
+			t.Errorf("Calculate() with -100%% inclusive => %v, expected ErrInvalidRates", err)
+		}
+	}
+}
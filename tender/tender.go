@@ -0,0 +1,150 @@
+// Package tender splits an order total over several means of payment, such as
+// gift cards, store credit and a card, and distributes refunds back over them.
+package tender
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/FoxComm/money"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrInsufficientFunds is returned when the tenders can't cover the total
+	ErrInsufficientFunds = errors.New("tenders don't cover the total")
+
+	// ErrRefundExceedsCharged is returned when refunding more than was charged
+	ErrRefundExceedsCharged = errors.New("refund exceeds the amount charged")
+
+	// ErrNegativeAmount is returned when allocating or refunding a negative
+	// amount
+	ErrNegativeAmount = errors.New("amount is negative")
+)
+
+// Tender is a means of payment, e.g. a gift card or credit card
+type Tender struct {
+	ID string
+
+	// Limit is the most the tender can pay, e.g. a gift card's balance. It's
+	// ignored if Unlimited is set, e.g. for a credit card.
+	Limit     money.Money
+	Unlimited bool
+}
+
+// Allocation is what a tender pays or gets refunded, and why
+type Allocation struct {
+	Tender Tender
+	Amount money.Money
+	Reason string
+}
+
+// Allocate decides how much each tender pays of total, in the given order of
+// priority: every tender pays up to its limit until the total is covered.
+// errors with ErrInsufficientFunds, money.ErrPrecisionLoss if total isn't in
+// minor units, or if the currencies are different.
+func Allocate(total money.Money, tenders ...Tender) ([]Allocation, error) {
+	if err := check(total); err != nil {
+		return nil, err
+	}
+
+	left := total
+	allocations := make([]Allocation, 0, len(tenders))
+	for _, t := range tenders {
+		amount, reason := left, fmt.Sprintf("covers the remaining %s", left)
+
+		if !t.Unlimited {
+			if err := check(t.Limit); err != nil {
+				return nil, err
+			}
+			limited, err := t.Limit.LessThan(left)
+			if err != nil {
+				return nil, err
+			}
+			if limited {
+				amount, reason = t.Limit, fmt.Sprintf("limited to its balance of %s", t.Limit)
+			}
+		}
+
+		left = left.MustSub(amount)
+		if amount.IsZero() {
+			reason = "not needed, the total is covered"
+		}
+		allocations = append(allocations, Allocation{t, amount, reason})
+	}
+
+	if left.IsPositive() {
+		return nil, ErrInsufficientFunds
+	}
+	return allocations, nil
+}
+
+// RefundMode decides which tenders a refund goes back to first
+type RefundMode int
+
+const (
+	// ReversePriority refunds the last charged tender first, e.g. the card
+	// before the gift card
+	ReversePriority RefundMode = iota
+
+	// Proportional refunds every tender in proportion to what it paid
+	Proportional
+)
+
+// Refund distributes amount back over the charged allocations. errors with
+// ErrRefundExceedsCharged, money.ErrPrecisionLoss if amount isn't in minor
+// units, or if the currencies are different.
+func Refund(amount money.Money, charged []Allocation, mode RefundMode) ([]Allocation, error) {
+	if err := check(amount); err != nil {
+		return nil, err
+	}
+
+	total := money.Zero(amount.Currency())
+	weights := make([]decimal.Decimal, len(charged))
+	for i, a := range charged {
+		var err error
+		if total, err = total.Add(a.Amount); err != nil {
+			return nil, err
+		}
+		weights[i] = a.Amount.Amount()
+	}
+	if exceeds, _ := amount.GreaterThan(total); exceeds {
+		return nil, ErrRefundExceedsCharged
+	}
+
+	refunds := make([]Allocation, len(charged))
+	if mode == Proportional && !amount.IsZero() {
+		parts, err := amount.Allocate(weights...)
+		if err != nil {
+			return nil, err
+		}
+		for i, a := range charged {
+			refunds[i] = Allocation{a.Tender, parts[i], fmt.Sprintf("%s of %s in proportion to %s charged", parts[i], amount, a.Amount)}
+		}
+		return refunds, nil
+	}
+
+	left := amount
+	for i := len(charged) - 1; i >= 0; i-- {
+		a := charged[i]
+		refund, reason := left, fmt.Sprintf("covers the remaining %s", left)
+		if limited, _ := a.Amount.LessThan(left); limited {
+			refund, reason = a.Amount, fmt.Sprintf("limited to the %s charged", a.Amount)
+		}
+		if refund.IsZero() {
+			reason = "not needed, the refund is covered"
+		}
+
+		left = left.MustSub(refund)
+		refunds[i] = Allocation{a.Tender, refund, reason}
+	}
+	return refunds, nil
+}
+
+func check(amount money.Money) error {
+	if amount.IsNegative() {
+		return ErrNegativeAmount
+	}
+	_, err := amount.ToExact()
+	return err
+}
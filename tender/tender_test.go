@@ -0,0 +1,92 @@
+package tender
+
+import (
+	"testing"
+
+	"github.com/FoxComm/money"
+	. "github.com/FoxComm/money/currency"
+)
+
+func m(amount string) money.Money {
+	return money.MustMakeFromString(amount, USD)
+}
+
+var (
+	giftCard    = Tender{ID: "gift-card", Limit: m("25")}
+	storeCredit = Tender{ID: "store-credit", Limit: m("10.50")}
+	card        = Tender{ID: "card", Unlimited: true}
+)
+
+func checkAllocations(t *testing.T, name string, allocations []Allocation, expected ...string) {
+	if len(allocations) != len(expected) {
+		t.Fatalf("%s => %d allocations, expected %d", name, len(allocations), len(expected))
+	}
+	for i, a := range allocations {
+		if !a.Amount.Equals(m(expected[i])) {
+			t.Errorf("%s => %s pays %s (%s), expected %s", name, a.Tender.ID, a.Amount, a.Reason, expected[i])
+		}
+		if a.Reason == "" {
+			t.Errorf("%s => %s has no reason", name, a.Tender.ID)
+		}
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	allocations, err := Allocate(m("50.99"), giftCard, storeCredit, card)
+	if err != nil {
+		t.Fatalf("Allocate() => unexpected error %s", err)
+	}
+	checkAllocations(t, "Allocate()", allocations, "25.00", "10.50", "15.49")
+
+	allocations, err = Allocate(m("20"), giftCard, storeCredit, card)
+	if err != nil {
+		t.Fatalf("Allocate() => unexpected error %s", err)
+	}
+	checkAllocations(t, "Allocate()", allocations, "20.00", "0", "0")
+
+	if _, err := Allocate(m("50"), giftCard, storeCredit); err != ErrInsufficientFunds {
+		t.Errorf("Allocate() => %v, expected ErrInsufficientFunds", err)
+	}
+	if _, err := Allocate(m("-1"), card); err != ErrNegativeAmount {
+		t.Errorf("Allocate() => %v, expected ErrNegativeAmount", err)
+	}
+	if _, err := Allocate(m("1.001"), card); err != money.ErrPrecisionLoss {
+		t.Errorf("Allocate() => %v, expected ErrPrecisionLoss", err)
+	}
+	if _, err := Allocate(m("1"), Tender{ID: "cad", Limit: money.MustMakeFromString("1", CAD)}); err == nil {
+		t.Errorf("Allocate() => expected ErrDifferentCurrency")
+	}
+}
+
+func TestRefund(t *testing.T) {
+	charged, err := Allocate(m("50.99"), giftCard, storeCredit, card)
+	if err != nil {
+		t.Fatalf("Allocate() => unexpected error %s", err)
+	}
+
+	refunds, err := Refund(m("20"), charged, ReversePriority)
+	if err != nil {
+		t.Fatalf("Refund() => unexpected error %s", err)
+	}
+	checkAllocations(t, "Refund(ReversePriority)", refunds, "0", "4.51", "15.49")
+
+	refunds, err = Refund(m("10"), charged, Proportional)
+	if err != nil {
+		t.Fatalf("Refund() => unexpected error %s", err)
+	}
+	// 25.00, 10.50 and 15.49 of 50.99 make 4.903, 2.059 and 3.037
+	checkAllocations(t, "Refund(Proportional)", refunds, "4.90", "2.06", "3.04")
+
+	refunds, err = Refund(m("50.99"), charged, Proportional)
+	if err != nil {
+		t.Fatalf("Refund() => unexpected error %s", err)
+	}
+	checkAllocations(t, "Refund(Proportional)", refunds, "25.00", "10.50", "15.49")
+
+	if _, err := Refund(m("51"), charged, ReversePriority); err != ErrRefundExceedsCharged {
+		t.Errorf("Refund() => %v, expected ErrRefundExceedsCharged", err)
+	}
+	if _, err := Refund(money.MustMakeFromString("1", CAD), charged, ReversePriority); err == nil {
+		t.Errorf("Refund() => expected ErrDifferentCurrency")
+	}
+}
@@ -0,0 +1,203 @@
+// Package totals rolls order line items up into subtotal, discount, tax,
+// shipping and grand total, so that lines always add up to the totals.
+package totals
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/FoxComm/money"
+	"github.com/FoxComm/money/currency"
+	"github.com/FoxComm/money/tax"
+	"github.com/shopspring/decimal"
+)
+
+// ErrNoItems is returned when calculating totals of an order without items
+var ErrNoItems = errors.New("order has no items")
+
+// Item is a line item of an order
+type Item struct {
+	UnitPrice money.Money
+	Quantity  decimal.Decimal
+
+	// Discounts are taken off the line, e.g. allocated from a promotion
+	Discounts []money.Money
+
+	// Rates are the tax rates charged on the line
+	Rates []tax.Rate
+}
+
+// Order is what totals are calculated for. Shipping may be left unset.
+type Order struct {
+	Items         []Item
+	Shipping      money.Money
+	ShippingRates []tax.Rate
+}
+
+// Policy is how totals are rounded to the currency's minor unit
+type Policy struct {
+	Rounding money.Rounding
+
+	// Strategy tax.PerLine rounds every line, and totals are sums of lines.
+	// tax.PerTotal rounds the totals, and the rounding residue is spread
+	// over the lines so they still add up.
+	Strategy tax.Strategy
+
+	// TaxMode tells whether unit prices and shipping include tax
+	TaxMode tax.Mode
+}
+
+// Line is the breakdown of one item
+type Line struct {
+	// Subtotal is unit price × quantity
+	Subtotal money.Money
+	Discount money.Money
+	Tax      money.Money
+
+	// Total is subtotal - discount, plus tax unless prices include it
+	Total money.Money
+}
+
+// Totals is the breakdown of an order. Lines add up to Subtotal and Discount,
+// and together with ShippingTax to Tax.
+type Totals struct {
+	Subtotal    money.Money
+	Discount    money.Money
+	Tax         money.Money
+	Shipping    money.Money
+	ShippingTax money.Money
+	Total       money.Money
+	Lines       []Line
+}
+
+// Calculate calculates the totals of order. errors if the currencies are
+// different or with ErrNoItems.
+func (p Policy) Calculate(order Order) (Totals, error) {
+	if len(order.Items) == 0 {
+		return Totals{}, ErrNoItems
+	}
+
+	cur := order.Items[0].UnitPrice.Currency()
+	n := len(order.Items)
+	subtotals := make([]money.Money, n)
+	discounts := make([]money.Money, n)
+	taxes := make([]money.Money, n, n+1)
+
+	for i, item := range order.Items {
+		subtotal := money.Make(item.UnitPrice.Amount().Mul(item.Quantity), item.UnitPrice.Currency())
+		calc := money.Chain(money.Zero(cur))
+		for _, discount := range item.Discounts {
+			calc = calc.Add(discount)
+		}
+		discount, err := calc.Result()
+		if err != nil {
+			return Totals{}, err
+		}
+
+		taxable, err := subtotal.Sub(discount)
+		if err != nil {
+			return Totals{}, err
+		}
+		if taxes[i], err = p.tax(taxable, item.Rates); err != nil {
+			return Totals{}, err
+		}
+		subtotals[i], discounts[i] = subtotal, discount
+	}
+
+	shipping := money.Zero(cur)
+	if !order.Shipping.IsZero() {
+		if _, err := shipping.Add(order.Shipping); err != nil {
+			return Totals{}, err
+		}
+		shipping = order.Shipping.RoundWith(p.Rounding)
+	}
+	shippingTax, err := p.tax(shipping, order.ShippingRates)
+	if err != nil {
+		return Totals{}, err
+	}
+	taxes = append(taxes, shippingTax)
+
+	var totals Totals
+	subtotals, totals.Subtotal = p.reconcile(cur, subtotals)
+	discounts, totals.Discount = p.reconcile(cur, discounts)
+	taxes, totals.Tax = p.reconcile(cur, taxes)
+	totals.Shipping, totals.ShippingTax = shipping, taxes[n]
+
+	totals.Lines = make([]Line, n)
+	for i := range totals.Lines {
+		totals.Lines[i] = Line{
+			Subtotal: subtotals[i],
+			Discount: discounts[i],
+			Tax:      taxes[i],
+			Total:    p.total(subtotals[i], discounts[i], taxes[i]),
+		}
+	}
+	totals.Total = p.total(totals.Subtotal, totals.Discount, totals.Tax).MustAdd(shipping)
+	return totals, nil
+}
+
+// tax is the unrounded tax of amount
+func (p Policy) tax(amount money.Money, rates []tax.Rate) (money.Money, error) {
+	calc := tax.Calculator{Rates: rates, Mode: p.TaxMode, Strategy: tax.PerTotal, Rounding: p.Rounding}
+	result, err := calc.Calculate(amount)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return result.Lines[0].Tax, nil
+}
+
+func (p Policy) total(subtotal, discount, t money.Money) money.Money {
+	total := subtotal.MustSub(discount)
+	if p.TaxMode == tax.Exclusive {
+		total = total.MustAdd(t)
+	}
+	return total
+}
+
+// reconcile rounds values according to the policy and sums them. Rounded
+// values always add up to the sum. Per total, every value is rounded and the
+// residue of rounding the sum instead is spread a minor unit at a time over
+// the values with the largest remainders, so values of both signs work.
+func (p Policy) reconcile(cur currency.Currency, values []money.Money) ([]money.Money, money.Money) {
+	rounded := make([]money.Money, len(values))
+	sum := money.Zero(cur)
+	exact := money.Zero(cur)
+
+	for i, v := range values {
+		rounded[i] = v.RoundWith(p.Rounding)
+		sum = sum.MustAdd(rounded[i])
+		exact = exact.MustAdd(v)
+	}
+	if p.Strategy == tax.PerLine || len(values) == 0 {
+		return rounded, sum
+	}
+
+	total := exact.RoundWith(p.Rounding)
+	residue := total.Amount().Sub(sum.Amount())
+	if residue.IsZero() {
+		return rounded, total
+	}
+
+	unit := decimal.New(1, -cur.Digits())
+	if residue.IsNegative() {
+		unit = unit.Neg()
+	}
+
+	// largest remainder in the direction of the residue first
+	order := make([]int, len(values))
+	remainders := make([]decimal.Decimal, len(values))
+	for i, v := range values {
+		order[i] = i
+		remainders[i] = v.Amount().Sub(rounded[i].Amount()).Mul(unit)
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].GreaterThan(remainders[order[b]])
+	})
+
+	for i := 0; !residue.IsZero(); i++ {
+		j := order[i%len(order)]
+		rounded[j] = money.Make(rounded[j].Amount().Add(unit), cur)
+		residue = residue.Sub(unit)
+	}
+	return rounded, total
+}
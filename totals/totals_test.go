@@ -0,0 +1,173 @@
+package totals
+
+import (
+	"testing"
+
+	"github.com/FoxComm/money"
+	. "github.com/FoxComm/money/currency"
+	"github.com/FoxComm/money/tax"
+	"github.com/shopspring/decimal"
+)
+
+var salesTax = []tax.Rate{{Name: "Sales tax", Percent: money.MakePercent(decimal.RequireFromString("8.875"))}}
+
+func m(amount string) money.Money {
+	return money.MustMakeFromString(amount, USD)
+}
+
+func qty(q int64) decimal.Decimal {
+	return decimal.New(q, 0)
+}
+
+func checkSums(t *testing.T, totals Totals) {
+	subtotal, discount, tx := m("0"), m("0"), totals.ShippingTax
+	for _, line := range totals.Lines {
+		subtotal = subtotal.MustAdd(line.Subtotal)
+		discount = discount.MustAdd(line.Discount)
+		tx = tx.MustAdd(line.Tax)
+	}
+
+	if !subtotal.Amount().Equals(totals.Subtotal.Amount()) ||
+		!discount.Amount().Equals(totals.Discount.Amount()) ||
+		!tx.Amount().Equals(totals.Tax.Amount()) {
+		t.Errorf("lines add up to %s, %s, %s, expected %s, %s, %s",
+			subtotal, discount, tx, totals.Subtotal, totals.Discount, totals.Tax)
+	}
+}
+
+func checkTotals(t *testing.T, totals Totals, subtotal, discount, tx, shipping, total string) {
+	if !totals.Subtotal.Equals(m(subtotal)) || !totals.Discount.Equals(m(discount)) ||
+		!totals.Tax.Equals(m(tx)) || !totals.Shipping.Equals(m(shipping)) || !totals.Total.Equals(m(total)) {
+		t.Errorf("totals => %s, %s, %s, %s, %s, expected %s, %s, %s, %s, %s",
+			totals.Subtotal, totals.Discount, totals.Tax, totals.Shipping, totals.Total,
+			subtotal, discount, tx, shipping, total)
+	}
+	checkSums(t, totals)
+}
+
+func order() Order {
+	return Order{
+		Items: []Item{
+			{UnitPrice: m("3.33"), Quantity: qty(3), Rates: salesTax},
+			{UnitPrice: m("0.99"), Quantity: qty(1), Rates: salesTax, Discounts: []money.Money{m("0.10")}},
+			{UnitPrice: m("1.01"), Quantity: qty(1), Rates: salesTax},
+		},
+		Shipping: m("5"),
+	}
+}
+
+func TestPerLine(t *testing.T) {
+	totals, err := Policy{}.Calculate(order())
+	if err != nil {
+		t.Fatalf("Calculate() => unexpected error %s", err)
+	}
+
+	// line taxes 0.886..., 0.079..., 0.089... round to 0.89, 0.08, 0.09
+	checkTotals(t, totals, "11.99", "0.10", "1.06", "5.00", "17.95")
+	if line := totals.Lines[1]; !line.Tax.Equals(m("0.08")) || !line.Total.Equals(m("0.97")) {
+		t.Errorf("line 1 => tax %s, total %s, expected 0.08, 0.97", line.Tax, line.Total)
+	}
+}
+
+func TestPerTotal(t *testing.T) {
+	totals, err := Policy{Strategy: tax.PerTotal}.Calculate(order())
+	if err != nil {
+		t.Fatalf("Calculate() => unexpected error %s", err)
+	}
+
+	// 11.89 × 8.875% = 1.0552..., the residue goes to the largest remainder
+	checkTotals(t, totals, "11.99", "0.10", "1.06", "5.00", "17.95")
+
+	totals, err = Policy{Strategy: tax.PerTotal, Rounding: money.RoundDown}.Calculate(order())
+	if err != nil {
+		t.Fatalf("Calculate() => unexpected error %s", err)
+	}
+	checkTotals(t, totals, "11.99", "0.10", "1.05", "5.00", "17.94")
+}
+
+func TestReconcileResidue(t *testing.T) {
+	third := money.Make(decimal.RequireFromString("1").Div(qty(3)), USD)
+	o := Order{Items: []Item{
+		{UnitPrice: third, Quantity: qty(1)},
+		{UnitPrice: third, Quantity: qty(1)},
+		{UnitPrice: third, Quantity: qty(1)},
+	}}
+
+	perLine, err := Policy{}.Calculate(o)
+	if err != nil {
+		t.Fatalf("Calculate() => unexpected error %s", err)
+	}
+	checkTotals(t, perLine, "0.99", "0", "0", "0", "0.99")
+
+	perTotal, err := Policy{Strategy: tax.PerTotal}.Calculate(o)
+	if err != nil {
+		t.Fatalf("Calculate() => unexpected error %s", err)
+	}
+	checkTotals(t, perTotal, "1.00", "0", "0", "0", "1.00")
+	if !perTotal.Lines[0].Subtotal.Equals(m("0.34")) {
+		t.Errorf("line 0 => %s, expected 0.34", perTotal.Lines[0].Subtotal)
+	}
+}
+
+func TestPerTotalMixedSigns(t *testing.T) {
+	third := money.Make(decimal.RequireFromString("10").Div(qty(3)), USD)
+	var orders = []struct {
+		order    Order
+		subtotal string
+		lines    []string
+	}{
+		{Order{Items: []Item{{UnitPrice: m("10"), Quantity: qty(1)}, {UnitPrice: m("-10"), Quantity: qty(1)}}}, "0", []string{"10.00", "-10.00"}},
+		{Order{Items: []Item{{UnitPrice: m("10"), Quantity: qty(1)}, {UnitPrice: m("-5"), Quantity: qty(1)}}}, "5.00", []string{"10.00", "-5.00"}},
+		{Order{Items: []Item{{UnitPrice: third, Quantity: qty(1)}, {UnitPrice: third.Negate(), Quantity: qty(2)}}}, "-3.33", []string{"3.33", "-6.66"}},
+		{Order{Items: []Item{{UnitPrice: third, Quantity: qty(2)}, {UnitPrice: third.Negate(), Quantity: qty(1)}}}, "3.33", []string{"6.66", "-3.33"}},
+	}
+
+	for _, o := range orders {
+		totals, err := Policy{Strategy: tax.PerTotal}.Calculate(o.order)
+		if err != nil {
+			t.Fatalf("Calculate() => unexpected error %s", err)
+		}
+		checkTotals(t, totals, o.subtotal, "0", "0", "0", o.subtotal)
+		for i, line := range totals.Lines {
+			if !line.Subtotal.Equals(m(o.lines[i])) {
+				t.Errorf("line %d => %s, expected %s", i, line.Subtotal, o.lines[i])
+			}
+		}
+	}
+}
+
+func TestInclusive(t *testing.T) {
+	vat := []tax.Rate{{Name: "VAT", Percent: money.MakePercent(qty(20))}}
+	o := Order{
+		Items:         []Item{{UnitPrice: m("12"), Quantity: qty(2), Rates: vat}},
+		Shipping:      m("6"),
+		ShippingRates: vat,
+	}
+
+	totals, err := Policy{TaxMode: tax.Inclusive}.Calculate(o)
+	if err != nil {
+		t.Fatalf("Calculate() => unexpected error %s", err)
+	}
+	checkTotals(t, totals, "24.00", "0", "5.00", "6.00", "30.00")
+	if !totals.ShippingTax.Equals(m("1")) {
+		t.Errorf("shipping tax => %s, expected 1.00", totals.ShippingTax)
+	}
+}
+
+func TestCalculateErrors(t *testing.T) {
+	if _, err := (Policy{}).Calculate(Order{}); err != ErrNoItems {
+		t.Errorf("Calculate() => %v, expected ErrNoItems", err)
+	}
+
+	o := order()
+	o.Items[1].UnitPrice = money.MustMakeFromString("1", CAD)
+	if _, err := (Policy{}).Calculate(o); err == nil {
+		t.Errorf("Calculate() => expected ErrDifferentCurrency for items")
+	}
+
+	o = order()
+	o.Shipping = money.MustMakeFromString("1", CAD)
+	if _, err := (Policy{}).Calculate(o); err == nil {
+		t.Errorf("Calculate() => expected ErrDifferentCurrency for shipping")
+	}
+}
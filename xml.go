@@ -0,0 +1,98 @@
+package money
+
+import (
+	"encoding/xml"
+	"strings"
+
+	"github.com/FoxComm/money/currency"
+	"github.com/shopspring/decimal"
+)
+
+// XMLCurrency encodes Money as an element with a currency attribute, e.g.
+// <Price currency="USD">10.00</Price>. Decoding accepts every form Money does.
+type XMLCurrency struct {
+	Money
+}
+
+// XMLCurrencyID encodes Money as an element with a currencyID attribute, as
+// used by UBL invoices, e.g. <cbc:PriceAmount currencyID="USD">10.00</cbc:PriceAmount>.
+// Decoding accepts every form Money does.
+type XMLCurrencyID struct {
+	Money
+}
+
+// xmlCurrencyAttrs are the attribute names recognized as the currency code
+var xmlCurrencyAttrs = []string{"currency", "currencyID"}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (x XMLCurrency) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(x.Money, "currency", e, start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (x *XMLCurrency) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(&x.Money, d, start)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (x XMLCurrencyID) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(x.Money, "currencyID", e, start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (x *XMLCurrencyID) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(&x.Money, d, start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface. It accepts the text
+// form <Price>USD 10.00</Price> as well as the attribute forms of XMLCurrency
+// and XMLCurrencyID. Money is encoded in the text form, see MarshalText.
+func (m *Money) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(m, d, start)
+}
+
+func marshalXML(m Money, attr string, e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: attr}, Value: m.currency.Code})
+	return e.EncodeElement(m.amountString(), start)
+}
+
+func unmarshalXML(m *Money, d *xml.Decoder, start xml.StartElement) error {
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return err
+	}
+	text = strings.TrimSpace(text)
+
+	code, ok := xmlCurrency(start)
+	if !ok {
+		parsed, err := Parse(text)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+		return nil
+	}
+
+	c, ok := currency.Table[code]
+	if !ok {
+		return &ErrUnknownCurrency{code}
+	}
+	amount, err := decimal.NewFromString(text)
+	if err != nil {
+		return &ErrParse{text, err}
+	}
+	*m = Make(amount, c)
+	return nil
+}
+
+// xmlCurrency finds the currency code among the attributes of start
+func xmlCurrency(start xml.StartElement) (string, bool) {
+	for _, attr := range start.Attr {
+		for _, name := range xmlCurrencyAttrs {
+			if attr.Name.Local == name {
+				return strings.TrimSpace(attr.Value), true
+			}
+		}
+	}
+	return "", false
+}
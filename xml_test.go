@@ -0,0 +1,86 @@
+package money
+
+import (
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	. "github.com/FoxComm/money/currency"
+)
+
+func TestUnmarshalXMLForms(t *testing.T) {
+	var forms = []struct {
+		xml      string
+		expected Money
+	}{
+		{`<Price>USD 10.00</Price>`, Make(d("10"), USD)},
+		{`<Price currency="USD">10.00</Price>`, Make(d("10"), USD)},
+		{`<Price currencyID="USD"> 10.5 </Price>`, Make(d("10.5"), USD)},
+		{`<cbc:PriceAmount xmlns:cbc="urn:cbc" currencyID="XAF">5000</cbc:PriceAmount>`, Make(d("5000"), XAF)},
+		{`<Price currency="USD">-0.333</Price>`, Make(d("-0.333"), USD)},
+	}
+
+	for _, f := range forms {
+		var m Money
+		if err := xml.Unmarshal([]byte(f.xml), &m); err != nil || !m.Equals(f.expected) {
+			t.Errorf("xml.Unmarshal(%s) => (%s, %v), expected %s", f.xml, m, err, f.expected)
+		}
+	}
+}
+
+func TestUnmarshalXMLErrors(t *testing.T) {
+	var m Money
+	var parse *ErrParse
+	for _, input := range []string{`<Price currency="USD">ten</Price>`, `<Price>USD</Price>`} {
+		if err := xml.Unmarshal([]byte(input), &m); !errors.As(err, &parse) {
+			t.Errorf("xml.Unmarshal(%s) => %v, expected ErrParse", input, err)
+		}
+	}
+
+	var unknown *ErrUnknownCurrency
+	if err := xml.Unmarshal([]byte(`<Price currencyID="ZZZ">10</Price>`), &m); !errors.As(err, &unknown) || unknown.Code != "ZZZ" {
+		t.Errorf("xml.Unmarshal() => %v, expected ErrUnknownCurrency", err)
+	}
+}
+
+func TestXMLAttributes(t *testing.T) {
+	type item struct {
+		XMLName xml.Name      `xml:"item"`
+		Price   XMLCurrency   `xml:"price"`
+		Sale    XMLCurrencyID `xml:"sale"`
+		Text    Money         `xml:"text"`
+	}
+	expected := item{
+		Price: XMLCurrency{Make(d("10"), USD)},
+		Sale:  XMLCurrencyID{Make(d("1.5"), XAU)},
+		Text:  Make(d("-0.05"), USD),
+	}
+	encoded := `<item><price currency="USD">10.00</price><sale currencyID="XAU">1.5</sale><text>USD -0.05</text></item>`
+
+	byt, err := xml.Marshal(expected)
+	if err != nil || string(byt) != encoded {
+		t.Errorf("xml.Marshal() => (%s, %v), expected %s", byt, err, encoded)
+	}
+
+	var actual item
+	if err := xml.Unmarshal([]byte(encoded), &actual); err != nil || !actual.Price.Equals(expected.Price.Money) ||
+		!actual.Sale.Equals(expected.Sale.Money) || !actual.Text.Equals(expected.Text) {
+		t.Errorf("xml.Unmarshal(%s) => (%+v, %v), expected %+v", encoded, actual, err, expected)
+	}
+}
+
+func TestXMLAttributeValue(t *testing.T) {
+	type tag struct {
+		Price Money `xml:"price,attr"`
+	}
+
+	byt, err := xml.Marshal(tag{Make(d("10"), USD)})
+	if err != nil || string(byt) != `<tag price="USD 10.00"></tag>` {
+		t.Errorf("xml.Marshal() => (%s, %v), expected <tag price=\"USD 10.00\"></tag>", byt, err)
+	}
+
+	var actual tag
+	if err := xml.Unmarshal(byt, &actual); err != nil || !actual.Price.Equals(Make(d("10"), USD)) {
+		t.Errorf("xml.Unmarshal(%s) => (%s, %v), expected USD 10.00", byt, actual.Price, err)
+	}
+}